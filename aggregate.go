@@ -0,0 +1,123 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Sum returns new Money struct with value representing the sum of all given Money.
+// It returns ErrNoMoney for empty input and ErrCurrencyMismatch for mixed currencies.
+func Sum(ms ...*Money) (*Money, error) {
+	if err := assertAllSameCurrency(ms, "Sum"); err != nil {
+		return nil, err
+	}
+
+	as := make([]Amount, len(ms))
+	for i, m := range ms {
+		as[i] = m.Amount()
+	}
+
+	c := ms[0].Currency()
+	a, err := mutate.calc.sum(0, as, false)
+	if err != nil {
+		return nil, overflowed(err, "Sum", c)
+	}
+
+	return &Money{amount: a, currency: c}, nil
+}
+
+// Min returns the smallest of the given Money.
+// It returns ErrNoMoney for empty input and ErrCurrencyMismatch for mixed currencies.
+func Min(ms ...*Money) (*Money, error) {
+	return pick(ms, -1, "Min")
+}
+
+// Max returns the largest of the given Money.
+// It returns ErrNoMoney for empty input and ErrCurrencyMismatch for mixed currencies.
+func Max(ms ...*Money) (*Money, error) {
+	return pick(ms, 1, "Max")
+}
+
+// Average returns new Money struct with value representing the arithmetic mean of all given Money,
+// rounded using the given mode. The sum is computed without overflow.
+// It returns ErrNoMoney for empty input and ErrCurrencyMismatch for mixed currencies.
+func Average(mode RoundingMode, ms ...*Money) (*Money, error) {
+	if err := assertAllSameCurrency(ms, "Average"); err != nil {
+		return nil, err
+	}
+
+	sum := new(big.Int)
+	for _, m := range ms {
+		sum = mutate.bigCalc.add(sum, big.NewInt(m.Amount()))
+	}
+
+	return mean(ms[0].Currency(), sum, int64(len(ms)), mode)
+}
+
+// Median returns new Money struct with value representing the median of all given Money.
+// For an even number of Money the mean of the two middle values is rounded using the given mode.
+// It returns ErrNoMoney for empty input and ErrCurrencyMismatch for mixed currencies.
+func Median(mode RoundingMode, ms ...*Money) (*Money, error) {
+	if err := assertAllSameCurrency(ms, "Median"); err != nil {
+		return nil, err
+	}
+
+	amounts := make([]int64, len(ms))
+	for i, m := range ms {
+		amounts[i] = m.Amount()
+	}
+
+	sort.Slice(amounts, func(i, j int) bool {
+		return amounts[i] < amounts[j]
+	})
+
+	n := len(amounts)
+	if n%2 == 1 {
+		return &Money{amount: amounts[n/2], currency: ms[0].Currency()}, nil
+	}
+
+	sum := mutate.bigCalc.add(big.NewInt(amounts[n/2-1]), big.NewInt(amounts[n/2]))
+	return mean(ms[0].Currency(), sum, 2, mode)
+}
+
+// pick returns a copy of the smallest Money for sign -1 and of the largest for sign 1.
+func pick(ms []*Money, sign int, op string) (*Money, error) {
+	if err := assertAllSameCurrency(ms, op); err != nil {
+		return nil, err
+	}
+
+	k := ms[0]
+	for _, m := range ms[1:] {
+		if m.compare(k) == sign {
+			k = m
+		}
+	}
+
+	return &Money{amount: k.Amount(), currency: k.Currency()}, nil
+}
+
+// mean returns sum / n rounded with the given mode as Money in the given currency.
+func mean(c *Currency, sum *big.Int, n int64, mode RoundingMode) (*Money, error) {
+	a, err := mutate.bigCalc.divideRound(sum, big.NewInt(n), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mean lies between the smallest and the largest amount, so it always fits.
+	return &Money{amount: a.Int64(), currency: c}, nil
+}
+
+// assertAllSameCurrency checks that ms isn't empty and all of its Money share the currency.
+func assertAllSameCurrency(ms []*Money, op string) error {
+	if len(ms) == 0 {
+		return ErrNoMoney
+	}
+
+	for _, m := range ms[1:] {
+		if err := ms[0].assertSameCurrency(m, op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func amountsToMoney(code string, amounts ...int64) []*Money {
+	ms := make([]*Money, 0, len(amounts))
+	for _, a := range amounts {
+		ms = append(ms, New(a, code))
+	}
+
+	return ms
+}
+
+func TestSum(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		expected int64
+		err      error
+	}{
+		{[]int64{5}, 5, nil},
+		{[]int64{5, 10, -3}, 12, nil},
+		{[]int64{math.MaxInt64, 1}, 0, ErrOverflow},
+		{nil, 0, ErrNoMoney},
+	}
+
+	for _, tc := range tcs {
+		r, err := Sum(amountsToMoney(EUR, tc.amounts...)...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected sum of %v to return error %v got %v", tc.amounts, tc.err, err)
+			continue
+		}
+
+		if err == nil && (r.amount != tc.expected || r.currency.Code != EUR) {
+			t.Errorf("Expected sum of %v to be %d %s got %d %s", tc.amounts, tc.expected, EUR, r.amount, r.currency.Code)
+		}
+	}
+
+	m := New(5, EUR)
+	if r, _ := Sum(m); r == m {
+		t.Error("Expected Sum to return a new Money")
+	}
+
+	var mismatch *CurrencyMismatchError
+	if _, err := Sum(&Money{}, New(1, EUR)); !errors.As(err, &mismatch) || mismatch.Op != "Sum" {
+		t.Errorf("Expected sum of mixed currencies to return a Sum currency mismatch got %v", err)
+	}
+
+	var overflow *OverflowError
+	if _, err := Sum(New(math.MaxInt64, EUR), New(1, EUR)); !errors.As(err, &overflow) || overflow.Op != "Sum" {
+		t.Errorf("Expected overflowing sum to return a Sum overflow got %v", err)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tcs := []struct {
+		amounts []int64
+		min     int64
+		max     int64
+	}{
+		{[]int64{5}, 5, 5},
+		{[]int64{5, -10, 3}, -10, 5},
+		{[]int64{math.MinInt64, math.MaxInt64, 0}, math.MinInt64, math.MaxInt64},
+		{[]int64{7, 7, 7}, 7, 7},
+	}
+
+	for _, tc := range tcs {
+		ms := amountsToMoney(EUR, tc.amounts...)
+
+		r, err := Min(ms...)
+		if err != nil || r.amount != tc.min {
+			t.Errorf("Expected min of %v to be %d got %v, %v", tc.amounts, tc.min, r, err)
+		}
+
+		r, err = Max(ms...)
+		if err != nil || r.amount != tc.max {
+			t.Errorf("Expected max of %v to be %d got %v, %v", tc.amounts, tc.max, r, err)
+		}
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{[]int64{5}, RoundHalfUp, 5},
+		{[]int64{1, 2}, RoundHalfUp, 2},
+		{[]int64{1, 2}, RoundHalfEven, 2},
+		{[]int64{1, 2}, RoundHalfDown, 1},
+		{[]int64{10, 20, 30, 41}, RoundDown, 25},
+		{[]int64{-1, -2}, RoundHalfUp, -2},
+		{[]int64{math.MaxInt64, math.MaxInt64, math.MaxInt64}, RoundHalfUp, math.MaxInt64},
+		{[]int64{math.MinInt64, math.MinInt64}, RoundHalfUp, math.MinInt64},
+		{[]int64{math.MinInt64, math.MaxInt64}, RoundFloor, -1},
+	}
+
+	for _, tc := range tcs {
+		r, err := Average(tc.mode, amountsToMoney(EUR, tc.amounts...)...)
+		if err != nil {
+			t.Errorf("Unexpected error averaging %v: %v", tc.amounts, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected average of %v with %v to be %d got %d", tc.amounts, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{[]int64{5}, RoundHalfUp, 5},
+		{[]int64{9, 1, 5}, RoundHalfUp, 5},
+		{[]int64{9, 1, 5, 2}, RoundHalfUp, 4},
+		{[]int64{9, 1, 5, 2}, RoundHalfDown, 3},
+		{[]int64{9, 1, 6, 2}, RoundHalfDown, 4},
+		{[]int64{math.MaxInt64, math.MaxInt64 - 1}, RoundHalfUp, math.MaxInt64},
+		{[]int64{math.MinInt64, math.MinInt64 + 1}, RoundHalfUp, math.MinInt64},
+	}
+
+	for _, tc := range tcs {
+		ms := amountsToMoney(EUR, tc.amounts...)
+		r, err := Median(tc.mode, ms...)
+		if err != nil {
+			t.Errorf("Unexpected error taking median of %v: %v", tc.amounts, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected median of %v with %v to be %d got %d", tc.amounts, tc.mode, tc.expected, r.amount)
+		}
+
+		// The input must not be reordered.
+		if ms[0].amount != tc.amounts[0] {
+			t.Errorf("Expected median not to reorder the input %v", tc.amounts)
+		}
+	}
+}
+
+func TestAggregates_Errors(t *testing.T) {
+	mixed := []*Money{New(1, EUR), New(2, EUR), New(3, USD)}
+
+	if _, err := Sum(mixed...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Min(mixed...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Max(mixed...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Average(RoundHalfUp, mixed...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Median(RoundHalfUp, mixed...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Min(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := Max(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := Average(RoundHalfUp); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := Median(RoundHalfUp); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := Average(RoundingMode(99), New(1, EUR)); !errors.Is(err, ErrInvalidRoundingMode) {
+		t.Errorf("Expected %v got %v", ErrInvalidRoundingMode, err)
+	}
+}
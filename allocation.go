@@ -0,0 +1,162 @@
+package money
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+)
+
+// Share describes one party of a Split or Allocate operation to an AllocationStrategy.
+type Share struct {
+	// Ratio is the ratio the party was allocated with, it is 1 for every party of a Split.
+	Ratio int64
+	// Remainder is the part of a subunit the party lost when its share was truncated,
+	// expressed as a numerator over the sum of all ratios.
+	Remainder int64
+}
+
+// AllocationStrategy decides which parties receive the leftover subunits of Split and Allocate.
+type AllocationStrategy interface {
+	// Order returns the indexes of all shares in the order in which their parties receive
+	// leftover subunits, one subunit each. Parties with a zero ratio are skipped.
+	Order(shares []Share) []int
+}
+
+// FirstParties hands leftover subunits to the parties listed first.
+// This is the strategy used by Split and Allocate.
+type FirstParties struct{}
+
+// Order implements AllocationStrategy.
+func (FirstParties) Order(shares []Share) []int {
+	return indexes(len(shares))
+}
+
+// LastParties hands leftover subunits to the parties listed last.
+type LastParties struct{}
+
+// Order implements AllocationStrategy.
+func (LastParties) Order(shares []Share) []int {
+	order := indexes(len(shares))
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	return order
+}
+
+// LargestRemainder hands leftover subunits to the parties that lost the most to truncation,
+// which is the Hamilton method. Ties go to the party listed first.
+type LargestRemainder struct{}
+
+// Order implements AllocationStrategy.
+func (LargestRemainder) Order(shares []Share) []int {
+	order := indexes(len(shares))
+	sort.SliceStable(order, func(i, j int) bool {
+		return shares[order[i]].Remainder > shares[order[j]].Remainder
+	})
+
+	return order
+}
+
+// LargestRatio hands leftover subunits to the parties with the largest ratio.
+// Ties go to the party listed first.
+type LargestRatio struct{}
+
+// Order implements AllocationStrategy.
+func (LargestRatio) Order(shares []Share) []int {
+	order := indexes(len(shares))
+	sort.SliceStable(order, func(i, j int) bool {
+		return shares[order[i]].Ratio > shares[order[j]].Ratio
+	})
+
+	return order
+}
+
+// SeededRandom hands leftover subunits to parties in a random order derived from Seed,
+// so the same seed always yields the same allocation and can be audited.
+type SeededRandom struct {
+	Seed int64
+}
+
+// Order implements AllocationStrategy.
+func (s SeededRandom) Order(shares []Share) []int {
+	order := indexes(len(shares))
+	rand.New(rand.NewSource(s.Seed)).Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+
+	return order
+}
+
+// distribute hands the leftover subunits lo one by one to the parties in the order chosen by the strategy,
+// calling give with the index of the party and the subunit, which is -1 for a negative leftover.
+// Parties with a zero ratio are skipped, whatever the strategy, as they're owed nothing.
+func distribute(shares []Share, lo int64, strategy AllocationStrategy, give func(p int, sub int64)) error {
+	if strategy == nil {
+		return errors.New("allocation strategy is required")
+	}
+
+	if lo == 0 {
+		return nil
+	}
+
+	order := strategy.Order(shares)
+	if !validOrder(order, len(shares)) {
+		return errors.New("allocation strategy returned an invalid order")
+	}
+
+	// The leftover is smaller than the number of parties with a ratio, the others only follow them
+	// so the order can't run out.
+	owed := make([]int, 0, len(order))
+	for _, i := range order {
+		if shares[i].Ratio != 0 {
+			owed = append(owed, i)
+		}
+	}
+
+	for _, i := range order {
+		if shares[i].Ratio == 0 {
+			owed = append(owed, i)
+		}
+	}
+
+	sub := int64(1)
+	if lo < 0 {
+		sub = -sub
+	}
+
+	for p := 0; lo != 0; p++ {
+		give(owed[p], sub)
+		lo -= sub
+	}
+
+	return nil
+}
+
+// indexes returns the slice [0, 1, ..., n-1].
+func indexes(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+
+	return order
+}
+
+// validOrder checks that order is a permutation of the indexes of n shares.
+func validOrder(order []int, n int) bool {
+	if len(order) != n {
+		return false
+	}
+
+	seen := make([]bool, n)
+	for _, i := range order {
+		if i < 0 || i >= n || seen[i] {
+			return false
+		}
+
+		seen[i] = true
+	}
+
+	return true
+}
@@ -0,0 +1,299 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// AllocationParty describes what a party of an AllocationPlan receives: either a Fixed amount,
+// or a share proportional to Ratio that is kept between the optional Min and Max bounds.
+type AllocationParty struct {
+	Ratio int
+	Fixed *Money
+	Min   *Money
+	Max   *Money
+}
+
+// RatioParty returns AllocationParty receiving a share proportional to r.
+func RatioParty(r int) AllocationParty {
+	return AllocationParty{Ratio: r}
+}
+
+// FixedParty returns AllocationParty receiving exactly m.
+func FixedParty(m *Money) AllocationParty {
+	return AllocationParty{Fixed: m}
+}
+
+// BoundedParty returns AllocationParty receiving a share proportional to r which is at least min
+// and at most max. Either bound may be nil.
+func BoundedParty(r int, min, max *Money) AllocationParty {
+	return AllocationParty{Ratio: r, Min: min, Max: max}
+}
+
+// AllocationPlan lists the parties of an allocation made with Money.AllocateByPlan.
+type AllocationPlan []AllocationParty
+
+// AllocateByPlan returns slice of Money structs with split Self value according to the plan.
+// Fixed parties are paid first, the rest is shared by ratio so that every bounded party stays within its bounds.
+// Like Allocate it doesn't lose pennies: leftover pennies go to the first parties whose share was truncated.
+func (m *Money) AllocateByPlan(plan AllocationPlan) ([]*Money, error) {
+	m = m.orZero()
+
+	if len(plan) == 0 {
+		return nil, errors.New("no parties specified")
+	}
+
+	// Resolve the plan as if the amount was positive and flip the results back at the end,
+	// so bounds keep their meaning for negative amounts such as refunds.
+	sign := int64(1)
+	if m.amount < 0 {
+		sign = -1
+	}
+
+	rest := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(sign))
+	los := make([]*big.Rat, len(plan))
+	his := make([]*big.Rat, len(plan))
+
+	for i, p := range plan {
+		for _, b := range []*Money{p.Fixed, p.Min, p.Max} {
+			if b == nil {
+				continue
+			}
+
+			if err := m.assertSameCurrency(b, "Allocate"); err != nil {
+				return nil, err
+			}
+		}
+
+		if p.Fixed != nil {
+			if p.Ratio != 0 || p.Min != nil || p.Max != nil {
+				return nil, errors.New("fixed parties can't have ratios or bounds")
+			}
+
+			rest.Sub(rest, new(big.Int).Mul(big.NewInt(p.Fixed.amount), big.NewInt(sign)))
+			continue
+		}
+
+		if p.Ratio < 0 {
+			return nil, errors.New("negative ratios not allowed")
+		}
+
+		lo, hi := p.Min, p.Max
+		if sign < 0 {
+			lo, hi = hi, lo
+		}
+
+		los[i] = new(big.Rat)
+		if lo != nil {
+			los[i].SetInt64(lo.amount)
+			los[i].Mul(los[i], big.NewRat(sign, 1))
+		}
+
+		if hi != nil {
+			his[i] = new(big.Rat).SetInt64(hi.amount)
+			his[i].Mul(his[i], big.NewRat(sign, 1))
+		}
+
+		if los[i].Sign() < 0 || (his[i] != nil && his[i].Sign() < 0) {
+			return nil, errors.New("bounds must have the same sign as the allocated amount")
+		}
+
+		if his[i] != nil && los[i].Cmp(his[i]) > 0 {
+			return nil, errors.New("minimum of a party exceeds its maximum")
+		}
+	}
+
+	if rest.Sign() < 0 {
+		return nil, errors.New("fixed parties exceed the allocated amount")
+	}
+
+	if rest.Sign() > 0 && !plan.hasShares() {
+		return nil, errors.New("fixed parties don't add up to the allocated amount")
+	}
+
+	if rest.Sign() > 0 && plan.hasShares() && !plan.hasRatios() {
+		// Only minimums can take a share, which can't grow past them.
+		mins := new(big.Rat)
+		for _, lo := range los {
+			if lo != nil {
+				mins.Add(mins, lo)
+			}
+		}
+
+		if mins.Cmp(new(big.Rat).SetInt(rest)) < 0 {
+			return nil, errors.New("sum of ratios is zero, the parties can't share the allocated amount")
+		}
+	}
+
+	shares, err := resolvePlan(plan, los, his, new(big.Rat).SetInt(rest))
+	if err != nil {
+		return nil, err
+	}
+
+	ms := make([]*Money, len(plan))
+	fractional := make([]int, 0, len(plan))
+	total := new(big.Int)
+
+	for i, p := range plan {
+		if p.Fixed != nil {
+			ms[i] = &Money{amount: p.Fixed.amount, currency: m.currency}
+			continue
+		}
+
+		// Shares aren't negative, so truncating is the same as flooring.
+		a := new(big.Int).Quo(shares[i].Num(), shares[i].Denom())
+		if !shares[i].IsInt() {
+			fractional = append(fractional, i)
+		}
+
+		total.Add(total, a)
+
+		// A negative fixed party can leave more than the allocated amount to share.
+		a.Mul(a, big.NewInt(sign))
+		if !a.IsInt64() {
+			return nil, overflowed(ErrOverflow, "Allocate", m.currency)
+		}
+
+		ms[i] = &Money{amount: a.Int64(), currency: m.currency}
+	}
+
+	// The truncated fractions add up to the leftover, so there are always enough parties to hand it to.
+	// Those parties are strictly below their maximum, which is a whole amount, so they stay within bounds.
+	lo := new(big.Int).Sub(rest, total).Int64()
+	for p := 0; lo != 0; p++ {
+		a, err := mutate.calc.add(ms[fractional[p]].amount, sign)
+		if err != nil {
+			return nil, err
+		}
+
+		ms[fractional[p]].amount = a
+		lo--
+	}
+
+	return ms, nil
+}
+
+// hasShares reports whether the plan has any party that isn't fixed.
+func (plan AllocationPlan) hasShares() bool {
+	for _, p := range plan {
+		if p.Fixed == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasRatios reports whether the plan has any party with a ratio above zero.
+func (plan AllocationPlan) hasRatios() bool {
+	for _, p := range plan {
+		if p.Fixed == nil && p.Ratio > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolvePlan shares rest amongst the ratio parties of the plan. Every party receives
+// clamp(λ * ratio, lo, hi) where λ is chosen so the shares add up to rest exactly.
+// Shares of fixed parties are left nil.
+func resolvePlan(plan AllocationPlan, los, his []*big.Rat, rest *big.Rat) ([]*big.Rat, error) {
+	// share returns what party i receives for the given λ.
+	share := func(i int, l *big.Rat) *big.Rat {
+		s := new(big.Rat).Mul(l, big.NewRat(int64(plan[i].Ratio), 1))
+		if s.Cmp(los[i]) < 0 {
+			s.Set(los[i])
+		}
+		if his[i] != nil && s.Cmp(his[i]) > 0 {
+			s.Set(his[i])
+		}
+
+		return s
+	}
+
+	sum := func(l *big.Rat) *big.Rat {
+		s := new(big.Rat)
+		for i, p := range plan {
+			if p.Fixed == nil {
+				s.Add(s, share(i, l))
+			}
+		}
+
+		return s
+	}
+
+	// The sum is piecewise linear and non-decreasing in λ with breakpoints where a party reaches a bound.
+	points := []*big.Rat{new(big.Rat)}
+	for i, p := range plan {
+		if p.Fixed != nil || p.Ratio == 0 {
+			continue
+		}
+
+		r := big.NewRat(int64(p.Ratio), 1)
+		points = append(points, new(big.Rat).Quo(los[i], r))
+		if his[i] != nil {
+			points = append(points, new(big.Rat).Quo(his[i], r))
+		}
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Cmp(points[j]) < 0
+	})
+
+	prev := points[0]
+	prevSum := sum(prev)
+	if prevSum.Cmp(rest) > 0 {
+		return nil, errors.New("minimums of the parties exceed the allocated amount")
+	}
+
+	var l *big.Rat
+	if prevSum.Cmp(rest) == 0 {
+		l = prev
+	}
+
+	for _, next := range points[1:] {
+		if l != nil {
+			break
+		}
+
+		nextSum := sum(next)
+		if nextSum.Cmp(rest) >= 0 {
+			// Interpolate linearly between the two breakpoints.
+			l = new(big.Rat).Sub(rest, prevSum)
+			l.Mul(l, new(big.Rat).Sub(next, prev))
+			l.Quo(l, new(big.Rat).Sub(nextSum, prevSum))
+			l.Add(l, prev)
+		}
+
+		prev, prevSum = next, nextSum
+	}
+
+	if l == nil {
+		// Past the last breakpoint only parties without a maximum keep growing.
+		slope := new(big.Rat)
+		for i, p := range plan {
+			if p.Fixed == nil && his[i] == nil {
+				slope.Add(slope, big.NewRat(int64(p.Ratio), 1))
+			}
+		}
+
+		if slope.Sign() == 0 {
+			return nil, errors.New("maximums of the parties don't cover the allocated amount")
+		}
+
+		l = new(big.Rat).Sub(rest, prevSum)
+		l.Quo(l, slope)
+		l.Add(l, prev)
+	}
+
+	shares := make([]*big.Rat, len(plan))
+	for i, p := range plan {
+		if p.Fixed == nil {
+			shares[i] = share(i, l)
+		}
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,160 @@
+package money
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMoney_AllocateByPlan(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		plan     AllocationPlan
+		expected []int64
+	}{
+		{
+			10000,
+			AllocationPlan{RatioParty(90), BoundedParty(10, New(100, EUR), New(1000, EUR)), FixedParty(New(500, EUR))},
+			[]int64{8550, 950, 500},
+		},
+		// The fee hits its cap and the seller gets the rest.
+		{
+			100000,
+			AllocationPlan{RatioParty(90), BoundedParty(10, New(100, EUR), New(1000, EUR)), FixedParty(New(500, EUR))},
+			[]int64{98500, 1000, 500},
+		},
+		// Parties without ratios are paid their minimums when those cover the amount.
+		{
+			100,
+			AllocationPlan{BoundedParty(0, New(60, EUR), nil), BoundedParty(0, New(40, EUR), nil)},
+			[]int64{60, 40},
+		},
+		{
+			100,
+			AllocationPlan{FixedParty(New(100, EUR)), RatioParty(0)},
+			[]int64{100, 0},
+		},
+		// The fee is raised to its floor.
+		{
+			1000,
+			AllocationPlan{RatioParty(90), BoundedParty(10, New(100, EUR), New(1000, EUR)), FixedParty(New(500, EUR))},
+			[]int64{400, 100, 500},
+		},
+		{
+			100,
+			AllocationPlan{RatioParty(1), RatioParty(1), RatioParty(1)},
+			[]int64{34, 33, 33},
+		},
+		// Leftover pennies skip parties sitting exactly on a bound.
+		{
+			100,
+			AllocationPlan{BoundedParty(1, nil, New(10, EUR)), RatioParty(1), RatioParty(1)},
+			[]int64{10, 45, 45},
+		},
+		{
+			101,
+			AllocationPlan{BoundedParty(1, nil, New(10, EUR)), RatioParty(1), RatioParty(1)},
+			[]int64{10, 46, 45},
+		},
+		{
+			100,
+			AllocationPlan{BoundedParty(1, New(50, EUR), nil), RatioParty(3)},
+			[]int64{50, 50},
+		},
+		{
+			100,
+			AllocationPlan{BoundedParty(0, New(30, EUR), nil), RatioParty(1)},
+			[]int64{30, 70},
+		},
+		{
+			100,
+			AllocationPlan{BoundedParty(1, nil, New(40, EUR)), BoundedParty(1, nil, New(60, EUR))},
+			[]int64{40, 60},
+		},
+		{
+			100,
+			AllocationPlan{FixedParty(New(60, EUR)), FixedParty(New(40, EUR))},
+			[]int64{60, 40},
+		},
+		{
+			100,
+			AllocationPlan{FixedParty(New(100, EUR)), RatioParty(1)},
+			[]int64{100, 0},
+		},
+		{
+			-10000,
+			AllocationPlan{RatioParty(90), BoundedParty(10, New(-1000, EUR), New(-100, EUR)), FixedParty(New(-500, EUR))},
+			[]int64{-8550, -950, -500},
+		},
+		{
+			-1000,
+			AllocationPlan{RatioParty(90), BoundedParty(10, New(-1000, EUR), New(-100, EUR)), FixedParty(New(-500, EUR))},
+			[]int64{-400, -100, -500},
+		},
+		{
+			math.MaxInt64,
+			AllocationPlan{RatioParty(1), RatioParty(1)},
+			[]int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2},
+		},
+	}
+
+	for _, tc := range tcs {
+		parties, err := New(tc.amount, EUR).AllocateByPlan(tc.plan)
+		if err != nil {
+			t.Errorf("Unexpected error allocating %d by plan %+v: %v", tc.amount, tc.plan, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range parties {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d by plan %+v to be %v got %v", tc.amount, tc.plan, tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_AllocateByPlan2(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		plan   AllocationPlan
+	}{
+		{100, nil},
+		{100, AllocationPlan{RatioParty(-1)}},
+		{100, AllocationPlan{RatioParty(1), FixedParty(New(10, USD))}},
+		{100, AllocationPlan{BoundedParty(1, New(10, USD), nil)}},
+		{100, AllocationPlan{FixedParty(New(101, EUR)), RatioParty(1)}},
+		{100, AllocationPlan{FixedParty(New(60, EUR)), FixedParty(New(30, EUR))}},
+		{100, AllocationPlan{{Ratio: 1, Fixed: New(10, EUR)}}},
+		{100, AllocationPlan{BoundedParty(1, New(60, EUR), New(50, EUR)), RatioParty(1)}},
+		{100, AllocationPlan{BoundedParty(1, New(60, EUR), nil), BoundedParty(1, New(60, EUR), nil)}},
+		{100, AllocationPlan{BoundedParty(1, nil, New(40, EUR)), BoundedParty(1, nil, New(40, EUR))}},
+		{100, AllocationPlan{BoundedParty(1, New(-10, EUR), nil)}},
+		{100, AllocationPlan{RatioParty(0), RatioParty(0)}},
+		{math.MaxInt64, AllocationPlan{FixedParty(New(-1, EUR)), RatioParty(1)}},
+	}
+
+	for _, tc := range tcs {
+		if _, err := New(tc.amount, EUR).AllocateByPlan(tc.plan); err == nil {
+			t.Errorf("Expected allocation of %d by plan %+v to fail", tc.amount, tc.plan)
+		}
+	}
+}
+
+func TestMoney_AllocateByPlan_ZeroRatios(t *testing.T) {
+	plans := []AllocationPlan{
+		{RatioParty(0), RatioParty(0)},
+		{RatioParty(0), BoundedParty(0, New(10, EUR), New(50, EUR))},
+		{FixedParty(New(10, EUR)), RatioParty(0)},
+	}
+
+	for _, plan := range plans {
+		_, err := New(100, EUR).AllocateByPlan(plan)
+		if err == nil || !strings.Contains(err.Error(), "sum of ratios is zero") {
+			t.Errorf("Expected sum of ratios is zero error for plan %+v got %v", plan, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package money
+
+import "math/big"
+
+// bigCalculator performs the arithmetic of BigMoney. Unlike calculator it never overflows.
+type bigCalculator struct{}
+
+func (c *bigCalculator) add(a, b *big.Int) *big.Int {
+	return new(big.Int).Add(a, b)
+}
+
+func (c *bigCalculator) subtract(a, b *big.Int) *big.Int {
+	return new(big.Int).Sub(a, b)
+}
+
+func (c *bigCalculator) multiply(a, m *big.Int) *big.Int {
+	return new(big.Int).Mul(a, m)
+}
+
+// divideRound returns a / d rounded with the given mode.
+func (c *bigCalculator) divideRound(a, d *big.Int, mode RoundingMode) (*big.Int, error) {
+	if d.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	if d.Sign() < 0 {
+		return quoRound(new(big.Int).Neg(a), new(big.Int).Neg(d), mode)
+	}
+
+	return quoRound(a, d, mode)
+}
+
+// divMod returns the quotient of a / d truncated toward zero and the remainder, which has the sign of a.
+func (c *bigCalculator) divMod(a, d *big.Int) (*big.Int, *big.Int, error) {
+	if d.Sign() == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+
+	q, r := new(big.Int).QuoRem(a, d, new(big.Int))
+	return q, r, nil
+}
+
+// allocate returns a * r / s truncated toward zero and the magnitude of the truncated remainder,
+// which is smaller than s. r and s must not be negative.
+func (c *bigCalculator) allocate(a *big.Int, r, s int64) (*big.Int, int64) {
+	if a.Sign() == 0 || s == 0 {
+		return new(big.Int), 0
+	}
+
+	q, rem := new(big.Int).QuoRem(new(big.Int).Mul(a, big.NewInt(r)), big.NewInt(s), new(big.Int))
+	return q, rem.Abs(rem).Int64()
+}
+
+func (c *bigCalculator) absolute(a *big.Int) *big.Int {
+	return new(big.Int).Abs(a)
+}
+
+func (c *bigCalculator) negative(a *big.Int) *big.Int {
+	if a.Sign() > 0 {
+		return new(big.Int).Neg(a)
+	}
+
+	return new(big.Int).Set(a)
+}
+
+// multiplyRat returns a * r rounded with the given mode. The product is computed exactly and rounded once.
+func (c *bigCalculator) multiplyRat(a *big.Int, r *big.Rat, mode RoundingMode) (*big.Int, error) {
+	return quoRound(new(big.Int).Mul(a, r.Num()), r.Denom(), mode)
+}
+
+// round rounds a to a multiple of 10^e using the given mode.
+// It returns ErrOverflow when e is negative or larger than MaxFraction.
+func (c *bigCalculator) round(a *big.Int, e int, mode RoundingMode) (*big.Int, error) {
+	exp, err := pow10Big(e)
+	if err != nil {
+		return nil, ErrOverflow
+	}
+
+	q, err := quoRound(a, exp, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Mul(q, exp), nil
+}
+
+// quoRound returns num / den rounded with the given mode, den must be positive.
+func quoRound(num, den *big.Int, mode RoundingMode) (*big.Int, error) {
+	if !mode.valid() {
+		return nil, ErrInvalidRoundingMode
+	}
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return q, nil
+	}
+
+	neg := num.Sign() < 0
+	rem.Abs(rem)
+	half := rem.Lsh(rem, 1).Cmp(den)
+	if mode.away(neg, half, q.Bit(0) != 0) {
+		if neg {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q, nil
+}
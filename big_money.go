@@ -0,0 +1,457 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// BigMoney represents monetary value information like Money, but stores the amount as an
+// arbitrary-precision integer, so it never overflows. Use it for currencies whose amounts
+// don't fit into int64 subunits, like tokens with 18 decimals or hyperinflation currencies.
+//
+// Like Money, the zero value BigMoney{} and a nil *BigMoney are a zero amount without a currency.
+type BigMoney struct {
+	amount   *big.Int
+	currency *Currency
+}
+
+// NewBig creates and returns new instance of BigMoney. The amount is copied.
+func NewBig(amount *big.Int, code string) *BigMoney {
+	a := new(big.Int)
+	if amount != nil {
+		a.Set(amount)
+	}
+
+	return &BigMoney{
+		amount:   a,
+		currency: newCurrency(code).get(),
+	}
+}
+
+// NewBigFromString creates and returns new instance of BigMoney from a base 10 string of subunits.
+func NewBigFromString(amount string, code string) (*BigMoney, error) {
+	a, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, &ParseError{Op: "NewBigFromString", Input: amount, Err: errors.New("not a base 10 integer")}
+	}
+
+	return &BigMoney{amount: a, currency: newCurrency(code).get()}, nil
+}
+
+// Big returns the value of Money as BigMoney.
+func (m *Money) Big() *BigMoney {
+	return &BigMoney{amount: big.NewInt(m.Amount()), currency: m.Currency()}
+}
+
+// Money returns the value of BigMoney as Money, or ErrOverflow if the amount doesn't fit into Amount.
+func (m *BigMoney) Money() (*Money, error) {
+	if !m.value().IsInt64() {
+		return nil, overflowed(ErrOverflow, "Money", m.Currency())
+	}
+
+	return &Money{amount: m.value().Int64(), currency: m.Currency()}, nil
+}
+
+// value returns the amount, treating a nil amount of the zero value or a nil *BigMoney as zero.
+func (m *BigMoney) value() *big.Int {
+	if m == nil || m.amount == nil {
+		return new(big.Int)
+	}
+
+	return m.amount
+}
+
+// Currency returns the currency used by BigMoney.
+func (m *BigMoney) Currency() *Currency {
+	if m == nil {
+		return nil
+	}
+
+	return m.currency
+}
+
+// Amount returns a copy of the internal monetary value.
+func (m *BigMoney) Amount() *big.Int {
+	return new(big.Int).Set(m.value())
+}
+
+// SameCurrency check if given BigMoney is equals by currency.
+func (m *BigMoney) SameCurrency(om *BigMoney) bool {
+	return m.Currency().equals(om.Currency())
+}
+
+func (m *BigMoney) assertSameCurrency(om *BigMoney, op string) error {
+	if !m.SameCurrency(om) {
+		return mismatch(op, m.Currency(), om.Currency())
+	}
+
+	return nil
+}
+
+func (m *BigMoney) compare(om *BigMoney) int {
+	return m.value().Cmp(om.value())
+}
+
+// Equals checks equality between two BigMoney types.
+func (m *BigMoney) Equals(om *BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om, "Equals"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) == 0, nil
+}
+
+// GreaterThan checks whether the value of BigMoney is greater than the other.
+func (m *BigMoney) GreaterThan(om *BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om, "GreaterThan"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) == 1, nil
+}
+
+// GreaterThanOrEqual checks whether the value of BigMoney is greater or equal than the other.
+func (m *BigMoney) GreaterThanOrEqual(om *BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om, "GreaterThanOrEqual"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) >= 0, nil
+}
+
+// LessThan checks whether the value of BigMoney is less than the other.
+func (m *BigMoney) LessThan(om *BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om, "LessThan"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) == -1, nil
+}
+
+// LessThanOrEqual checks whether the value of BigMoney is less or equal than the other.
+func (m *BigMoney) LessThanOrEqual(om *BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om, "LessThanOrEqual"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) <= 0, nil
+}
+
+// Compare function compares two BigMoney of the same currency, returning 1, 0 or -1 like Money.Compare.
+// If compare BigMoney from distinct currency, return (0, ErrCurrencyMismatch).
+func (m *BigMoney) Compare(om *BigMoney) (int, error) {
+	if err := m.assertSameCurrency(om, "Compare"); err != nil {
+		return 0, err
+	}
+
+	return m.compare(om), nil
+}
+
+// IsZero returns boolean of whether the value of BigMoney is equals to zero.
+func (m *BigMoney) IsZero() bool {
+	return m.value().Sign() == 0
+}
+
+// IsPositive returns boolean of whether the value of BigMoney is positive.
+func (m *BigMoney) IsPositive() bool {
+	return m.value().Sign() > 0
+}
+
+// IsNegative returns boolean of whether the value of BigMoney is negative.
+func (m *BigMoney) IsNegative() bool {
+	return m.value().Sign() < 0
+}
+
+// Absolute returns new BigMoney struct from given BigMoney using absolute monetary value.
+func (m *BigMoney) Absolute() *BigMoney {
+	return &BigMoney{amount: mutate.bigCalc.absolute(m.value()), currency: m.Currency()}
+}
+
+// Negative returns new BigMoney struct from given BigMoney using negative monetary value.
+func (m *BigMoney) Negative() *BigMoney {
+	return &BigMoney{amount: mutate.bigCalc.negative(m.value()), currency: m.Currency()}
+}
+
+// commonCurrency returns the currency shared by Self and ms, like Money.commonCurrency
+// it lets the zero value BigMoney go with BigMoney of any currency.
+func (m *BigMoney) commonCurrency(ms []*BigMoney, op string) (*Currency, error) {
+	base := m
+	for _, m2 := range ms {
+		switch {
+		case m2.Currency() == nil:
+			continue
+		case base.Currency() == nil:
+			base = m2
+		default:
+			if err := base.assertSameCurrency(m2, op); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return base.Currency(), nil
+}
+
+// Add returns new BigMoney struct with value representing sum of Self and Other BigMoney.
+// The zero value BigMoney adopts the currency of the other BigMoney.
+func (m *BigMoney) Add(ms ...*BigMoney) (*BigMoney, error) {
+	c, err := m.commonCurrency(ms, "Add")
+	if err != nil {
+		return nil, err
+	}
+
+	k := m.value()
+
+	for _, m2 := range ms {
+		k = mutate.bigCalc.add(k, m2.value())
+	}
+
+	return &BigMoney{amount: new(big.Int).Set(k), currency: c}, nil
+}
+
+// Subtract returns new BigMoney struct with value representing difference of Self and Other BigMoney.
+// The zero value BigMoney adopts the currency of the other BigMoney.
+func (m *BigMoney) Subtract(ms ...*BigMoney) (*BigMoney, error) {
+	c, err := m.commonCurrency(ms, "Subtract")
+	if err != nil {
+		return nil, err
+	}
+
+	k := m.value()
+
+	for _, m2 := range ms {
+		k = mutate.bigCalc.subtract(k, m2.value())
+	}
+
+	return &BigMoney{amount: new(big.Int).Set(k), currency: c}, nil
+}
+
+// Multiply returns new BigMoney struct with value representing Self multiplied value by multipliers.
+func (m *BigMoney) Multiply(muls ...int64) *BigMoney {
+	k := m.value()
+
+	for _, m2 := range muls {
+		k = mutate.bigCalc.multiply(k, big.NewInt(m2))
+	}
+
+	return &BigMoney{amount: new(big.Int).Set(k), currency: m.Currency()}
+}
+
+// MultiplyRat returns new BigMoney struct with value representing Self multiplied by the rational factor r.
+// The product is computed exactly and rounded once using the given mode.
+func (m *BigMoney) MultiplyRat(r *big.Rat, mode RoundingMode) (*BigMoney, error) {
+	if r == nil {
+		return nil, errors.New("factor is required to multiply")
+	}
+
+	a, err := mutate.bigCalc.multiplyRat(m.value(), r, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigMoney{amount: a, currency: m.Currency()}, nil
+}
+
+// MultiplyDecimal returns new BigMoney struct with value representing Self multiplied by the decimal factor d.
+// The product is computed exactly and rounded once using the given mode.
+func (m *BigMoney) MultiplyDecimal(d string, mode RoundingMode) (*BigMoney, error) {
+	r, err := parseDecimal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MultiplyRat(r, mode)
+}
+
+// Round returns new BigMoney struct with value rounded to the nearest whole major unit, ties are rounded toward zero.
+func (m *BigMoney) Round() *BigMoney {
+	r, _ := m.RoundWithMode(RoundHalfDown)
+	return r
+}
+
+// RoundWithMode returns new BigMoney struct with value rounded to a whole major unit using the given rounding mode.
+func (m *BigMoney) RoundWithMode(mode RoundingMode) (*BigMoney, error) {
+	a, err := mutate.bigCalc.round(m.value(), m.Currency().get().Fraction, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigMoney{amount: a, currency: m.Currency()}, nil
+}
+
+// Divide returns new BigMoney struct with value representing Self divided by d, rounded using the given mode.
+// Dividing by zero returns ErrDivisionByZero.
+func (m *BigMoney) Divide(d int64, mode RoundingMode) (*BigMoney, error) {
+	a, err := mutate.bigCalc.divideRound(m.value(), big.NewInt(d), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigMoney{amount: a, currency: m.Currency()}, nil
+}
+
+// DivMod returns the quotient of Self divided by d truncated toward zero and the leftover subunits,
+// so that quotient * d + remainder equals Self. Dividing by zero returns ErrDivisionByZero.
+func (m *BigMoney) DivMod(d int64) (*BigMoney, *BigMoney, error) {
+	q, r, err := mutate.bigCalc.divMod(m.value(), big.NewInt(d))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &BigMoney{amount: q, currency: m.Currency()}, &BigMoney{amount: r, currency: m.Currency()}, nil
+}
+
+// Split returns slice of BigMoney structs with split Self value in given number.
+// After division leftover subunits will be distributed round-robin amongst the parties listed first.
+func (m *BigMoney) Split(n int) ([]*BigMoney, error) {
+	return m.SplitWithStrategy(n, FirstParties{})
+}
+
+// SplitWithStrategy returns slice of BigMoney structs with split Self value in given number.
+// After division leftover subunits will be distributed amongst the parties in the order chosen by the strategy.
+func (m *BigMoney) SplitWithStrategy(n int, strategy AllocationStrategy) ([]*BigMoney, error) {
+	if n <= 0 {
+		return nil, errors.New("split must be higher than zero")
+	}
+
+	rs := make([]int, n)
+	for i := range rs {
+		rs[i] = 1
+	}
+
+	return m.AllocateWithStrategy(strategy, rs...)
+}
+
+// Allocate returns slice of BigMoney structs with split Self value in given ratios.
+// It doesn't lose subunits and distributes leftover subunits amongst the parties listed first.
+func (m *BigMoney) Allocate(rs ...int) ([]*BigMoney, error) {
+	return m.AllocateWithStrategy(FirstParties{}, rs...)
+}
+
+// AllocateWithStrategy returns slice of BigMoney structs with split Self value in given ratios.
+// It doesn't lose subunits and distributes leftover subunits amongst the parties in the order chosen by the strategy.
+func (m *BigMoney) AllocateWithStrategy(strategy AllocationStrategy, rs ...int) ([]*BigMoney, error) {
+	if len(rs) == 0 {
+		return nil, errors.New("no ratios specified")
+	}
+
+	// Calculate sum of ratios.
+	var sum int64
+	for _, r := range rs {
+		if r < 0 {
+			return nil, errors.New("negative ratios not allowed")
+		}
+		if int64(r) > (math.MaxInt64 - sum) {
+			return nil, errors.New("sum of given ratios exceeds max int")
+		}
+		sum += int64(r)
+	}
+
+	total := new(big.Int)
+	ms := make([]*BigMoney, 0, len(rs))
+	shares := make([]Share, 0, len(rs))
+	for _, r := range rs {
+		a, rem := mutate.bigCalc.allocate(m.value(), int64(r), sum)
+
+		ms = append(ms, &BigMoney{amount: a, currency: m.Currency()})
+		shares = append(shares, Share{Ratio: int64(r), Remainder: rem})
+		total.Add(total, a)
+	}
+
+	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
+	// with the leftover
+	if sum == 0 {
+		return ms, nil
+	}
+
+	// The leftover is smaller than the number of parties, so it fits into int64.
+	lo := new(big.Int).Sub(m.value(), total).Int64()
+	give := func(p int, sub int64) { ms[p].amount.Add(ms[p].amount, big.NewInt(sub)) }
+	if err := distribute(shares, lo, strategy, give); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
+
+// Display lets represent BigMoney struct as string in given Currency value.
+func (m *BigMoney) Display() string {
+	c := m.Currency().get()
+	return c.Formatter().FormatBig(m.value())
+}
+
+// AsMajorUnits lets represent BigMoney struct as major units (float64) in given Currency value.
+// Precision is lost for amounts that don't fit into float64.
+func (m *BigMoney) AsMajorUnits() float64 {
+	c := m.Currency().get()
+	return c.Formatter().ToMajorUnitsBig(m.value())
+}
+
+// MarshalJSON is implementation of json.Marshaller.
+// The amount is written as a JSON number with all of its digits.
+func (m BigMoney) MarshalJSON() ([]byte, error) {
+	if m == (BigMoney{}) {
+		return []byte(`{"amount": 0, "currency": ""}`), nil
+	}
+
+	buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %s, "currency": "%s"}`, m.value().String(), m.Currency().Code))
+	return buff.Bytes(), nil
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller.
+// The amount may be a JSON number or a string holding an integer of any size.
+func (m *BigMoney) UnmarshalJSON(b []byte) error {
+	if m == nil {
+		return errors.New("can't unmarshal into nil BigMoney")
+	}
+
+	data := make(map[string]interface{})
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&data); err != nil {
+		return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: err}
+	}
+
+	amount := new(big.Int)
+	if amountRaw, ok := data["amount"]; ok {
+		var s string
+		switch v := amountRaw.(type) {
+		case json.Number:
+			s = v.String()
+		case string:
+			s = v
+		default:
+			return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: ErrInvalidJSONUnmarshal}
+		}
+
+		if _, ok := amount.SetString(s, 10); !ok {
+			return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: ErrInvalidJSONUnmarshal}
+		}
+	}
+
+	var currency string
+	if currencyRaw, ok := data["currency"]; ok {
+		currency, ok = currencyRaw.(string)
+		if !ok {
+			return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: ErrInvalidJSONUnmarshal}
+		}
+	}
+
+	if amount.Sign() == 0 && currency == "" {
+		*m = BigMoney{}
+		return nil
+	}
+
+	r := m.currency.registryOrNil()
+	if err := r.assertKnown(currency); err != nil {
+		return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: err}
+	}
+
+	*m = BigMoney{amount: amount, currency: r.get(strings.ToUpper(currency))}
+	return nil
+}
@@ -0,0 +1,496 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// token is a currency with 18 decimals whose amounts don't fit into int64.
+var token = AddCurrency("TOKEN", "TKN", "1 $", ".", ",", 18).Code
+
+func mustBig(t *testing.T, amount string, code string) *BigMoney {
+	t.Helper()
+
+	m, err := NewBigFromString(amount, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func TestNewBig(t *testing.T) {
+	a := big.NewInt(100)
+	m := NewBig(a, EUR)
+	a.SetInt64(200)
+
+	if m.Amount().Int64() != 100 {
+		t.Errorf("Expected %d got %s", 100, m.Amount())
+	}
+
+	if m.Currency().Code != EUR {
+		t.Errorf("Expected currency %s got %s", EUR, m.Currency().Code)
+	}
+
+	m.Amount().SetInt64(300)
+	if m.Amount().Int64() != 100 {
+		t.Errorf("Expected Amount to return a copy, got %s", m.Amount())
+	}
+
+	if !NewBig(nil, EUR).IsZero() {
+		t.Error("Expected nil amount to be zero")
+	}
+}
+
+func TestNewBigFromString(t *testing.T) {
+	m := mustBig(t, "-123456789012345678901234567890", "eur")
+
+	if m.Amount().String() != "-123456789012345678901234567890" {
+		t.Errorf("Expected %s got %s", "-123456789012345678901234567890", m.Amount())
+	}
+
+	if m.Currency().Code != EUR {
+		t.Errorf("Expected currency %s got %s", EUR, m.Currency().Code)
+	}
+
+	for _, s := range []string{"", "1.5", "abc", "1e3"} {
+		if _, err := NewBigFromString(s, EUR); err == nil {
+			t.Errorf("Expected error parsing %q", s)
+		}
+	}
+}
+
+func TestBigMoney_Money(t *testing.T) {
+	m, err := New(math.MinInt64, EUR).Big().Money()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != math.MinInt64 || m.Currency().Code != EUR {
+		t.Errorf("Expected %d %s got %d %s", int64(math.MinInt64), EUR, m.Amount(), m.Currency().Code)
+	}
+
+	sum, _ := New(math.MaxInt64, EUR).Big().Add(NewBig(big.NewInt(1), EUR))
+	if _, err := sum.Money(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestBigMoney_Comparison(t *testing.T) {
+	small := mustBig(t, "100000000000000000000", token)
+	large := mustBig(t, "200000000000000000000", token)
+	other := mustBig(t, "200000000000000000000", EUR)
+
+	if r, err := small.GreaterThan(large); err != nil || r {
+		t.Errorf("Expected %s Greater Than %s == %t got %t", small.Amount(), large.Amount(), false, r)
+	}
+
+	if r, err := small.GreaterThanOrEqual(small); err != nil || !r {
+		t.Errorf("Expected %s Greater Than Or Equal %s == %t got %t", small.Amount(), small.Amount(), true, r)
+	}
+
+	if r, err := small.LessThan(large); err != nil || !r {
+		t.Errorf("Expected %s Less Than %s == %t got %t", small.Amount(), large.Amount(), true, r)
+	}
+
+	if r, err := large.LessThanOrEqual(small); err != nil || r {
+		t.Errorf("Expected %s Less Than Or Equal %s == %t got %t", large.Amount(), small.Amount(), false, r)
+	}
+
+	if r, err := large.Equals(mustBig(t, "200000000000000000000", token)); err != nil || !r {
+		t.Errorf("Expected %s Equals %s == %t got %t", large.Amount(), large.Amount(), true, r)
+	}
+
+	if r, err := large.Compare(small); err != nil || r != 1 {
+		t.Errorf("Expected %s Compare %s == %d got %d", large.Amount(), small.Amount(), 1, r)
+	}
+
+	if _, err := large.Equals(other); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := large.Compare(other); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestBigMoney_Asserts(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		zero     bool
+		positive bool
+		negative bool
+		absolute string
+		neg      string
+	}{
+		{"-100000000000000000000", false, false, true, "100000000000000000000", "-100000000000000000000"},
+		{"0", true, false, false, "0", "0"},
+		{"100000000000000000000", false, true, false, "100000000000000000000", "-100000000000000000000"},
+	}
+
+	for _, tc := range tcs {
+		m := mustBig(t, tc.amount, EUR)
+
+		if m.IsZero() != tc.zero || m.IsPositive() != tc.positive || m.IsNegative() != tc.negative {
+			t.Errorf("Unexpected asserts for %s: zero %t positive %t negative %t", tc.amount,
+				m.IsZero(), m.IsPositive(), m.IsNegative())
+		}
+
+		if r := m.Absolute().Amount().String(); r != tc.absolute {
+			t.Errorf("Expected absolute %s to be %s got %s", tc.amount, tc.absolute, r)
+		}
+
+		if r := m.Negative().Amount().String(); r != tc.neg {
+			t.Errorf("Expected negative %s to be %s got %s", tc.amount, tc.neg, r)
+		}
+	}
+}
+
+func TestBigMoney_AddSubtract(t *testing.T) {
+	m := New(math.MaxInt64, EUR).Big()
+
+	r, err := m.Add(m, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount().String() != "27670116110564327421" {
+		t.Errorf("Expected %s got %s", "27670116110564327421", r.Amount())
+	}
+
+	r, err = r.Subtract(m, m, m, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount().String() != "-9223372036854775807" {
+		t.Errorf("Expected %s got %s", "-9223372036854775807", r.Amount())
+	}
+
+	if r, err := m.Add(NewBig(big.NewInt(1), USD)); r != nil || !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if r, err := m.Subtract(NewBig(big.NewInt(1), USD)); r != nil || !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if r, _ := m.Add(); r.Amount().Int64() != math.MaxInt64 {
+		t.Errorf("Expected %d got %s", int64(math.MaxInt64), r.Amount())
+	}
+}
+
+func TestBigMoney_ZeroValue(t *testing.T) {
+	for _, z := range []*BigMoney{{}, nil} {
+		if z.Amount().Sign() != 0 || z.Currency() != nil || !z.IsZero() {
+			t.Errorf("Expected %#v to be zero without currency", z)
+		}
+
+		if r := z.Display(); r != "0.00" {
+			t.Errorf("Expected %#v to display as 0.00 got %s", z, r)
+		}
+
+		if m, err := z.Money(); err != nil || m.Amount() != 0 || m.Currency() != nil {
+			t.Errorf("Expected %#v as Money to be the zero value got %v, %v", z, m, err)
+		}
+
+		// Add and Subtract adopt the currency of the other BigMoney.
+		r, err := z.Add(NewBig(big.NewInt(100), EUR))
+		if err != nil || r.Amount().Int64() != 100 || r.Currency().Code != EUR {
+			t.Errorf("Expected zero value + €1.00 to be €1.00 got %v, %v", r, err)
+		}
+
+		r, err = NewBig(big.NewInt(100), EUR).Subtract(z, NewBig(big.NewInt(50), EUR))
+		if err != nil || r.Amount().Int64() != 50 || r.Currency().Code != EUR {
+			t.Errorf("Expected €1.00 - zero value - €0.50 to be €0.50 got %v, %v", r, err)
+		}
+
+		if _, err := z.Add(NewBig(big.NewInt(1), EUR), NewBig(big.NewInt(1), USD)); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+		}
+
+		if _, err := z.Equals(NewBig(nil, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+		}
+
+		if r := z.Multiply(3); r.Amount().Sign() != 0 || r.Currency() != nil {
+			t.Errorf("Expected zero value * 3 to be the zero value got %v", r)
+		}
+
+		if ms, err := z.Split(2); err != nil || len(ms) != 2 || ms[1].Currency() != nil {
+			t.Errorf("Expected zero value split in zero values got %v, %v", ms, err)
+		}
+	}
+}
+
+func TestBigMoney_Multiply(t *testing.T) {
+	m := New(math.MaxInt64, EUR).Big()
+
+	if r := m.Multiply(2, -3).Amount().String(); r != "-55340232221128654842" {
+		t.Errorf("Expected %s got %s", "-55340232221128654842", r)
+	}
+
+	if r := m.Multiply().Amount().Int64(); r != math.MaxInt64 {
+		t.Errorf("Expected %d got %d", int64(math.MaxInt64), r)
+	}
+
+	r, err := m.MultiplyRat(big.NewRat(3, 2), RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount().String() != "13835058055282163711" {
+		t.Errorf("Expected %s got %s", "13835058055282163711", r.Amount())
+	}
+
+	r, err = mustBig(t, "1000000000000000000", token).MultiplyDecimal("1.0834", RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount().String() != "1083400000000000000" {
+		t.Errorf("Expected %s got %s", "1083400000000000000", r.Amount())
+	}
+
+	if _, err := m.MultiplyRat(nil, RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := m.MultiplyDecimal("x", RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestBigMoney_Round(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		mode     RoundingMode
+		expected string
+	}{
+		{"1500000000000000000", RoundHalfEven, "2000000000000000000"},
+		{"2500000000000000000", RoundHalfEven, "2000000000000000000"},
+		{"2500000000000000000", RoundHalfUp, "3000000000000000000"},
+		{"-2500000000000000001", RoundHalfDown, "-3000000000000000000"},
+		{"1", RoundCeiling, "1000000000000000000"},
+		{"-1", RoundCeiling, "0"},
+	}
+
+	for _, tc := range tcs {
+		r, err := mustBig(t, tc.amount, token).RoundWithMode(tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error rounding %s: %v", tc.amount, err)
+			continue
+		}
+
+		if r.Amount().String() != tc.expected {
+			t.Errorf("Expected %s rounded with %v to be %s got %s", tc.amount, tc.mode, tc.expected, r.Amount())
+		}
+	}
+
+	if r := mustBig(t, "2500000000000000000", token).Round().Amount().String(); r != "2000000000000000000" {
+		t.Errorf("Expected %s got %s", "2000000000000000000", r)
+	}
+
+	for _, e := range []int{-1, MaxFraction + 1, 1 << 40} {
+		if _, err := mutate.bigCalc.round(big.NewInt(1), e, RoundHalfUp); err != ErrOverflow {
+			t.Errorf("Expected rounding to 10^%d to return %v got %v", e, ErrOverflow, err)
+		}
+	}
+}
+
+func TestBigMoney_Divide(t *testing.T) {
+	m := mustBig(t, "100000000000000000000", token)
+
+	r, err := m.Divide(3, RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount().String() != "33333333333333333333" {
+		t.Errorf("Expected %s got %s", "33333333333333333333", r.Amount())
+	}
+
+	r, err = m.Divide(-3, RoundUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount().String() != "-33333333333333333334" {
+		t.Errorf("Expected %s got %s", "-33333333333333333334", r.Amount())
+	}
+
+	q, rem, err := m.DivMod(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if q.Amount().String() != "33333333333333333333" || rem.Amount().Int64() != 1 {
+		t.Errorf("Expected (%s, %d) got (%s, %s)", "33333333333333333333", 1, q.Amount(), rem.Amount())
+	}
+
+	if _, err := m.Divide(0, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+
+	if _, _, err := m.DivMod(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+}
+
+func TestBigMoney_Split(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		split    int
+		strategy AllocationStrategy
+		expected []string
+	}{
+		{"100", 3, FirstParties{}, []string{"34", "33", "33"}},
+		{"-101", 4, FirstParties{}, []string{"-26", "-25", "-25", "-25"}},
+		{"100", 3, LastParties{}, []string{"33", "33", "34"}},
+		{"100000000000000000000", 3, FirstParties{}, []string{"33333333333333333334", "33333333333333333333", "33333333333333333333"}},
+	}
+
+	for _, tc := range tcs {
+		parties, err := mustBig(t, tc.amount, EUR).SplitWithStrategy(tc.split, tc.strategy)
+		if err != nil {
+			t.Errorf("Unexpected error splitting %s: %v", tc.amount, err)
+			continue
+		}
+
+		var rs []string
+		for _, party := range parties {
+			rs = append(rs, party.Amount().String())
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected split of %s to be %v got %v", tc.amount, tc.expected, rs)
+		}
+	}
+
+	if _, err := mustBig(t, "100", EUR).Split(0); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestBigMoney_Allocate(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		ratios   []int
+		expected []string
+	}{
+		{"100", []int{50, 50}, []string{"50", "50"}},
+		{"100", []int{30, 30, 30}, []string{"34", "33", "33"}},
+		{"5", []int{50, 25, 25}, []string{"3", "1", "1"}},
+		{"0", []int{0, 0}, []string{"0", "0"}},
+		{"10", []int{0, 0}, []string{"0", "0"}},
+		{"100000000000000000000", []int{1, 2}, []string{"33333333333333333334", "66666666666666666666"}},
+	}
+
+	for _, tc := range tcs {
+		parties, err := mustBig(t, tc.amount, EUR).Allocate(tc.ratios...)
+		if err != nil {
+			t.Errorf("Unexpected error allocating %s: %v", tc.amount, err)
+			continue
+		}
+
+		var rs []string
+		for _, party := range parties {
+			rs = append(rs, party.Amount().String())
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %s for ratios %v to be %v got %v", tc.amount, tc.ratios, tc.expected, rs)
+		}
+	}
+
+	if _, err := mustBig(t, "100", EUR).Allocate(); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := mustBig(t, "100", EUR).Allocate(1, -1); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestBigMoney_Display(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+		major    float64
+	}{
+		{"123456789", EUR, "€1,234,567.89", 1234567.89},
+		{"-123456789012345678901", USD, "-$1,234,567,890,123,456,789.01", -1234567890123456789.01},
+	}
+
+	for _, tc := range tcs {
+		m := mustBig(t, tc.amount, tc.code)
+
+		if r := m.Display(); r != tc.expected {
+			t.Errorf("Expected formatted %s to be %s got %s", tc.amount, tc.expected, r)
+		}
+
+		if r := m.AsMajorUnits(); r != tc.major {
+			t.Errorf("Expected value as major units of %s to be %f got %f", tc.amount, tc.major, r)
+		}
+	}
+}
+
+func TestBigMoney_JSON(t *testing.T) {
+	given := mustBig(t, "123456789012345678901234567890", EUR)
+	expected := `{"amount":123456789012345678901234567890,"currency":"EUR"}`
+
+	b, err := json.Marshal(given)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	var m BigMoney
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+
+	if eq, err := m.Equals(given); err != nil || !eq {
+		t.Errorf("Expected %s got %s", given.Display(), m.Display())
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount": "-42", "currency": "USD"}`), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "-$0.42" {
+		t.Errorf("Expected %s got %s", "-$0.42", m.Display())
+	}
+
+	b, err = json.Marshal(BigMoney{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"amount":0,"currency":""}` {
+		t.Errorf("Expected %s got %s", `{"amount":0,"currency":""}`, string(b))
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &m); err != nil || m != (BigMoney{}) {
+		t.Errorf("Expected zero value, got %+v, %v", m, err)
+	}
+
+	for _, given := range []string{
+		`{"amount": 1.5, "currency": "USD"}`,
+		`{"amount": "foo", "currency": "USD"}`,
+		`{"amount": true, "currency": "USD"}`,
+		`{"amount": 1, "currency": 1}`,
+	} {
+		if err := json.Unmarshal([]byte(given), &m); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+			t.Errorf("Expected ErrInvalidJSONUnmarshal for %s, got %v", given, err)
+		}
+	}
+}
@@ -1,19 +1,67 @@
 package money
 
-import "math"
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
 
 type calculator struct{}
 
-func (c *calculator) add(a, b Amount) Amount {
-	return a + b
+// add returns a + b. On overflow the wrapped sum is returned together with ErrOverflow.
+func (c *calculator) add(a, b Amount) (Amount, error) {
+	s := a + b
+	if (b > 0 && s < a) || (b < 0 && s > a) {
+		return s, ErrOverflow
+	}
+
+	return s, nil
 }
 
-func (c *calculator) subtract(a, b Amount) Amount {
-	return a - b
+// subtract returns a - b. On overflow the wrapped difference is returned together with ErrOverflow.
+func (c *calculator) subtract(a, b Amount) (Amount, error) {
+	d := a - b
+	if (b > 0 && d > a) || (b < 0 && d < a) {
+		return d, ErrOverflow
+	}
+
+	return d, nil
 }
 
-func (c *calculator) multiply(a Amount, m int64) Amount {
-	return a * m
+// sum returns a plus every b, or a minus every b when subtract is set. The sum is accumulated in 128 bits,
+// so only the final result has to fit into int64, e.g. -1 + MaxInt64 + 1 doesn't overflow.
+func (c *calculator) sum(a Amount, bs []Amount, subtract bool) (Amount, error) {
+	hi, lo := a>>63, uint64(a)
+	for _, b := range bs {
+		var carry uint64
+		if subtract {
+			lo, carry = bits.Sub64(lo, uint64(b), 0)
+			hi = hi - b>>63 - int64(carry)
+		} else {
+			lo, carry = bits.Add64(lo, uint64(b), 0)
+			hi = hi + b>>63 + int64(carry)
+		}
+	}
+
+	if hi != int64(lo)>>63 {
+		return int64(lo), ErrOverflow
+	}
+
+	return int64(lo), nil
+}
+
+// multiply returns a * m. On overflow the wrapped product is returned together with ErrOverflow.
+func (c *calculator) multiply(a Amount, m int64) (Amount, error) {
+	p := a * m
+	if a == 0 || m == 0 {
+		return p, nil
+	}
+
+	if (a == -1 && m == math.MinInt64) || (m == -1 && a == math.MinInt64) || p/m != a {
+		return p, ErrOverflow
+	}
+
+	return p, nil
 }
 
 func (c *calculator) divide(a Amount, d int64) Amount {
@@ -24,12 +72,73 @@ func (c *calculator) modulus(a Amount, d int64) Amount {
 	return a % d
 }
 
-func (c *calculator) allocate(a Amount, r, s int64) Amount {
+// divMod returns the quotient of a / d truncated toward zero and the remainder,
+// which has the sign of a, so that q*d + r == a.
+func (c *calculator) divMod(a Amount, d int64) (Amount, Amount, error) {
+	if d == 0 {
+		return 0, 0, ErrDivisionByZero
+	}
+
+	if a == math.MinInt64 && d == -1 {
+		return 0, 0, ErrOverflow
+	}
+
+	return c.divide(a, d), c.modulus(a, d), nil
+}
+
+// allocate returns a * r / s truncated toward zero and the magnitude of the truncated remainder,
+// |a| * r mod s. The intermediate product is computed with 128 bits so it can't wrap,
+// r and s must not be negative.
+func (c *calculator) allocate(a Amount, r, s int64) (Amount, int64, error) {
 	if a == 0 || s == 0 {
-		return 0
+		return 0, 0, nil
+	}
+
+	hi, lo := bits.Mul64(c.magnitude(a), uint64(r))
+	if hi >= uint64(s) {
+		return 0, 0, ErrOverflow
+	}
+
+	q, rem := bits.Div64(hi, lo, uint64(s))
+	if a < 0 {
+		if q > 1<<63 {
+			return 0, 0, ErrOverflow
+		}
+
+		return -int64(q), int64(rem), nil
+	}
+
+	if q > math.MaxInt64 {
+		return 0, 0, ErrOverflow
+	}
+
+	return int64(q), int64(rem), nil
+}
+
+// allocateRat returns a * r / s truncated toward zero, computed exactly. r and s must not be negative.
+func (c *calculator) allocateRat(a Amount, r, s *big.Rat) (Amount, error) {
+	if a == 0 || s.Sign() == 0 {
+		return 0, nil
+	}
+
+	x := new(big.Rat).Mul(new(big.Rat).SetInt64(a), r)
+	x.Quo(x, s)
+
+	q := new(big.Int).Quo(x.Num(), x.Denom())
+	if !q.IsInt64() {
+		return 0, ErrOverflow
 	}
 
-	return a * r / s
+	return q.Int64(), nil
+}
+
+// magnitude returns |a| as uint64, which unlike absolute also holds |math.MinInt64|.
+func (c *calculator) magnitude(a Amount) uint64 {
+	if a < 0 {
+		return uint64(-a)
+	}
+
+	return uint64(a)
 }
 
 func (c *calculator) absolute(a Amount) Amount {
@@ -48,26 +157,95 @@ func (c *calculator) negative(a Amount) Amount {
 	return a
 }
 
-func (c *calculator) round(a Amount, e int) Amount {
-	if a == 0 {
-		return 0
+// divideRound returns a / d rounded with the given mode.
+func (c *calculator) divideRound(a Amount, d int64, mode RoundingMode) (Amount, error) {
+	if !mode.valid() {
+		return 0, ErrInvalidRoundingMode
 	}
 
-	absam := c.absolute(a)
-	exp := int64(math.Pow(10, float64(e)))
-	m := absam % exp
+	if d == 0 {
+		return 0, ErrDivisionByZero
+	}
 
-	if m > (exp / 2) {
-		absam += exp
+	if a == math.MinInt64 && d == -1 {
+		return 0, ErrOverflow
 	}
 
-	absam = (absam / exp) * exp
+	q, r := a/d, a%d
+	if r == 0 {
+		return q, nil
+	}
 
-	if a < 0 {
-		a = -absam
-	} else {
-		a = absam
+	// Whether the exact quotient is negative, r != 0 so it can't be zero.
+	neg := (a < 0) != (d < 0)
+	// Compare |r| with |d| - |r| rather than 2|r| with |d| to avoid overflow.
+	rm, dm := c.magnitude(r), c.magnitude(d)
+	half := 0
+	switch {
+	case rm > dm-rm:
+		half = 1
+	case rm < dm-rm:
+		half = -1
 	}
 
-	return a
+	// |d| >= 2 here, so stepping q one unit away from zero can't overflow.
+	if mode.away(neg, half, q%2 != 0) {
+		if neg {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	return q, nil
+}
+
+// multiplyRat returns a * r rounded with the given mode. The product is computed exactly and rounded once.
+func (c *calculator) multiplyRat(a Amount, r *big.Rat, mode RoundingMode) (Amount, error) {
+	q, err := quoRound(new(big.Int).Mul(big.NewInt(a), r.Num()), r.Denom(), mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	return q.Int64(), nil
+}
+
+// pow10 returns 10^e, e must be between 0 and 18 for the result to fit into int64.
+func (c *calculator) pow10(e int) (int64, error) {
+	if e < 0 || e > 18 {
+		return 0, ErrOverflow
+	}
+
+	p := int64(1)
+	for i := 0; i < e; i++ {
+		p *= 10
+	}
+
+	return p, nil
+}
+
+// round rounds a to a multiple of 10^e using the given mode.
+// On overflow the wrapped result is returned together with ErrOverflow.
+func (c *calculator) round(a Amount, e int, mode RoundingMode) (Amount, error) {
+	exp, err := c.pow10(e)
+	if err != nil {
+		return a, err
+	}
+
+	return c.roundToIncrement(a, exp, mode)
+}
+
+// roundToIncrement rounds a to a multiple of inc using the given mode.
+// On overflow the wrapped result is returned together with ErrOverflow.
+func (c *calculator) roundToIncrement(a Amount, inc int64, mode RoundingMode) (Amount, error) {
+	q, err := c.divideRound(a, inc, mode)
+	if err != nil {
+		return a, err
+	}
+
+	return c.multiply(q, inc)
 }
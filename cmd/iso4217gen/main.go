@@ -0,0 +1,521 @@
+// Command iso4217gen regenerates the currency constants and the numeric codes and fractions
+// of the currency list from the ISO 4217 list one XML, and reports how the list changed.
+//
+// Usage:
+//
+//	go run ./cmd/iso4217gen [-xml list-one.xml] [-download [-url URL] | -offline] [-dir .] [-n] [-force]
+//
+// The list is read from the copy checked in as cmd/iso4217gen/list-one.xml, so go generate
+// doesn't need the network. With -download the copy is first replaced by the list published
+// by the ISO 4217 maintenance agency, and the changes of both are reviewed together.
+// With -offline no list is read, currency.go and constants.go are only rewritten from the
+// current currencies, e.g. after editing currency.go by hand.
+//
+// ISO codes missing from the XML are kept, as removing them would break code using them and
+// historical records, but they get the withdrawn status and their constants are deprecated.
+// Codes which were never part of ISO 4217, see nonISO, are left as they are.
+// As a truncated list would withdraw most of the table, more than maxWithdrawn withdrawals
+// are refused unless -force is given.
+// Withdrawal dates aren't part of list one, ValidTo has to be filled in by hand.
+// Formatting data such as graphemes and templates, symbols and ISO 3166 country codes aren't
+// part of ISO 4217 either, they're kept as is and reported to be filled in by hand for added currencies.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// listPath is the checked-in copy of list one, relative to the directory of the money package.
+const listPath = "cmd/iso4217gen/list-one.xml"
+
+// listURL is where the ISO 4217 maintenance agency publishes list one.
+const listURL = "https://www.six-group.com/dam/download/financial-information/data-center/iso-currrency/lists/list-one.xml"
+
+// maxWithdrawn is the number of withdrawals above which the list is taken as truncated.
+// Only a handful of currencies are withdrawn each year.
+const maxWithdrawn = 5
+
+// nonISO are the codes in use which were never part of ISO 4217, e.g. the pounds of the
+// Crown Dependencies. They don't have a numeric code and are never withdrawn.
+var nonISO = map[string]bool{
+	"GGP": true,
+	"IMP": true,
+	"JEP": true,
+}
+
+// isoCurrency is a currency of the ISO 4217 list one.
+type isoCurrency struct {
+	Code        string
+	NumericCode string
+	Fraction    int
+	Name        string
+}
+
+// isoList is the XML document of the ISO 4217 list one.
+type isoList struct {
+	Published string `xml:"Pblshd,attr"`
+	Entries   []struct {
+		Name     string `xml:"CcyNm"`
+		Code     string `xml:"Ccy"`
+		Numeric  string `xml:"CcyNbr"`
+		Fraction string `xml:"CcyMnrUnts"`
+	} `xml:"CcyTbl>CcyNtry"`
+}
+
+// parseList reads the ISO 4217 list one, merging the entries of a currency used by several countries.
+// Entries without a currency, e.g. for Antarctica, are skipped.
+func parseList(r io.Reader) (map[string]*isoCurrency, error) {
+	var l isoList
+	if err := xml.NewDecoder(r).Decode(&l); err != nil {
+		return nil, fmt.Errorf("parsing ISO 4217 list: %w", err)
+	}
+
+	cs := make(map[string]*isoCurrency)
+	for _, e := range l.Entries {
+		code := strings.TrimSpace(e.Code)
+		if code == "" {
+			continue
+		}
+
+		// Currencies without minor units, such as gold, use N.A.
+		fraction, err := strconv.Atoi(strings.TrimSpace(e.Fraction))
+		if err != nil {
+			fraction = 0
+		}
+
+		if _, ok := cs[code]; !ok {
+			cs[code] = &isoCurrency{
+				Code:        code,
+				NumericCode: strings.TrimSpace(e.Numeric),
+				Fraction:    fraction,
+				Name:        strings.TrimSpace(e.Name),
+			}
+		}
+	}
+
+	if len(cs) == 0 {
+		return nil, fmt.Errorf("parsing ISO 4217 list: no currencies found")
+	}
+
+	return cs, nil
+}
+
+// field is a field of a currency literal, with its value as source code.
+type field struct {
+	Key   string
+	Value string
+}
+
+// entry is a currency of the currencies literal.
+type entry struct {
+	Code   string
+	Fields []field
+}
+
+func (e *entry) get(key string) string {
+	for _, f := range e.Fields {
+		if f.Key == key {
+			return f.Value
+		}
+	}
+
+	return ""
+}
+
+func (e *entry) set(key, value string) {
+	for i, f := range e.Fields {
+		if f.Key == key {
+			e.Fields[i].Value = value
+			return
+		}
+	}
+
+	e.Fields = append(e.Fields, field{Key: key, Value: value})
+}
+
+func (e *entry) String() string {
+	fs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		fs[i] = f.Key + ": " + f.Value
+	}
+
+	return fmt.Sprintf("%s: {%s},", e.Code, strings.Join(fs, ", "))
+}
+
+// currencyList is the currencies literal of currency.go.
+type currencyList struct {
+	src     []byte
+	lbrace  int
+	rbrace  int
+	entries []*entry
+}
+
+// parseCurrencies finds the currencies literal in the source of currency.go.
+func parseCurrencies(src []byte) (*currencyList, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "currency.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var lit *ast.CompositeLit
+	ast.Inspect(f, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok || len(vs.Names) != 1 || vs.Names[0].Name != "currencies" || len(vs.Values) != 1 {
+			return lit == nil
+		}
+
+		lit, _ = vs.Values[0].(*ast.CompositeLit)
+		return false
+	})
+
+	if lit == nil {
+		return nil, fmt.Errorf("currency.go: currencies literal not found")
+	}
+
+	text := func(n ast.Node) string {
+		return string(src[fset.Position(n.Pos()).Offset:fset.Position(n.End()).Offset])
+	}
+
+	cl := &currencyList{src: src, lbrace: fset.Position(lit.Lbrace).Offset, rbrace: fset.Position(lit.Rbrace).Offset}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("currency.go: unexpected element %s", text(elt))
+		}
+
+		v, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("currency.go: unexpected currency %s", text(kv.Value))
+		}
+
+		e := &entry{Code: strings.Trim(text(kv.Key), `"`)}
+		for _, fe := range v.Elts {
+			fkv, ok := fe.(*ast.KeyValueExpr)
+			if !ok {
+				return nil, fmt.Errorf("currency.go: unexpected field %s of %s", text(fe), e.Code)
+			}
+
+			e.Fields = append(e.Fields, field{Key: text(fkv.Key), Value: text(fkv.Value)})
+		}
+
+		cl.entries = append(cl.entries, e)
+	}
+
+	return cl, nil
+}
+
+// withdrawn tells whether e is an ISO currency in use which isn't in the list any more.
+// Codes without a numeric code were never in the ISO list and aren't withdrawn.
+func withdrawn(e *entry, iso map[string]*isoCurrency) bool {
+	if _, ok := iso[e.Code]; ok || nonISO[e.Code] {
+		return false
+	}
+
+	return e.get("Status") == "" && e.get("NumericCode") != `""`
+}
+
+// update sets the numeric codes and fractions of the ISO currencies and adds the missing ones.
+// ISO currencies which aren't in the list any more get the withdrawn status.
+func (cl *currencyList) update(iso map[string]*isoCurrency) {
+	known := make(map[string]*entry, len(cl.entries))
+	for _, e := range cl.entries {
+		known[e.Code] = e
+		if withdrawn(e, iso) {
+			e.set("Status", "CurrencyWithdrawn")
+		}
+	}
+
+	for code, c := range iso {
+		fraction := strconv.Itoa(c.Fraction)
+		e, ok := known[code]
+		if !ok {
+			// Formatting isn't part of ISO 4217, added currencies get the defaults of unknown currencies.
+			e = &entry{Code: code, Fields: []field{
+				{"Decimal", `"."`},
+				{"Thousand", `","`},
+				{"Code", code},
+				{"Fraction", fraction},
+				{"NumericCode", `""`},
+				{"Grapheme", strconv.Quote(code)},
+				{"Template", `"1$"`},
+				{"Name", strconv.Quote(c.Name)},
+				{"AccountingFraction", fraction},
+				{"CashFraction", fraction},
+			}}
+			cl.entries = append(cl.entries, e)
+		}
+
+		// The accounting and cash fractions follow the ISO fraction unless they differ from it.
+		for _, key := range []string{"AccountingFraction", "CashFraction"} {
+			if e.get(key) == e.get("Fraction") {
+				e.set(key, fraction)
+			}
+		}
+
+		e.set("Fraction", fraction)
+		e.set("NumericCode", strconv.Quote(c.NumericCode))
+	}
+
+	sort.Slice(cl.entries, func(i, j int) bool {
+		return cl.entries[i].Code < cl.entries[j].Code
+	})
+}
+
+// source returns the source of currency.go with the updated currencies literal.
+func (cl *currencyList) source() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(cl.src[:cl.lbrace+1])
+	buf.WriteString("\n")
+	for _, e := range cl.entries {
+		buf.WriteString("\t" + e.String() + "\n")
+	}
+	buf.Write(cl.src[cl.rbrace:])
+
+	return format.Source(buf.Bytes())
+}
+
+// constants returns the source of constants.go. The codes of withdrawn currencies are deprecated.
+func constants(codes []string, cl *currencyList) ([]byte, error) {
+	status := make(map[string]string, len(cl.entries))
+	for _, e := range cl.entries {
+		status[e.Code] = e.get("Status")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by iso4217gen; DO NOT EDIT.\n\npackage money\n\n")
+	buf.WriteString("// Constants for currency codes according to the ISO 4217 standard.\nconst (\n")
+	for _, code := range codes {
+		if status[code] == "CurrencyWithdrawn" {
+			fmt.Fprintf(&buf, "\t// Deprecated: %s was withdrawn from ISO 4217.\n", code)
+		}
+
+		fmt.Fprintf(&buf, "\t%s = %q\n", code, code)
+	}
+	buf.WriteString(")\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// existingConstants returns the string constants declared in the source of constants.go.
+func existingConstants(src []byte) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "constants.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+
+		for _, s := range gd.Specs {
+			for _, name := range s.(*ast.ValueSpec).Names {
+				codes = append(codes, name.Name)
+			}
+		}
+	}
+
+	return codes, nil
+}
+
+// report writes the currencies added to and withdrawn from the list, and the changed ones,
+// and returns the number of withdrawn currencies.
+func report(w io.Writer, cl *currencyList, iso map[string]*isoCurrency) int {
+	known := make(map[string]*entry, len(cl.entries))
+	for _, e := range cl.entries {
+		known[e.Code] = e
+	}
+
+	var added, withdrawals, changed []string
+	for code, c := range iso {
+		e, ok := known[code]
+		switch {
+		case !ok:
+			added = append(added, fmt.Sprintf("%s %s (%s): fill in the plural name, countries, symbols and formatting",
+				code, c.NumericCode, c.Name))
+		case e.get("Fraction") != strconv.Itoa(c.Fraction) || e.get("NumericCode") != strconv.Quote(c.NumericCode):
+			changed = append(changed, fmt.Sprintf("%s: numeric code %s -> %q, fraction %s -> %d",
+				code, e.get("NumericCode"), c.NumericCode, e.get("Fraction"), c.Fraction))
+		}
+	}
+
+	for _, e := range cl.entries {
+		if withdrawn(e, iso) {
+			withdrawals = append(withdrawals, e.Code)
+		}
+	}
+
+	for _, s := range []struct {
+		title string
+		lines []string
+	}{{"Added", added}, {"Withdrawn", withdrawals}, {"Changed", changed}} {
+		sort.Strings(s.lines)
+		fmt.Fprintf(w, "%s: %d\n", s.title, len(s.lines))
+		for _, l := range s.lines {
+			fmt.Fprintf(w, "  %s\n", l)
+		}
+	}
+
+	return len(withdrawals)
+}
+
+// options are the flags of run.
+type options struct {
+	// dryRun only writes the report.
+	dryRun bool
+	// force writes the files even when more than maxWithdrawn currencies are withdrawn.
+	force bool
+}
+
+// run updates currency.go and constants.go in dir from the ISO list read from r.
+// Without a list, r is nil, the files are only rewritten from the current currencies.
+func run(r io.Reader, dir string, w io.Writer, opts options) error {
+	var iso map[string]*isoCurrency
+	if r != nil {
+		var err error
+		if iso, err = parseList(r); err != nil {
+			return err
+		}
+	}
+
+	currencySrc, err := os.ReadFile(filepath.Join(dir, "currency.go"))
+	if err != nil {
+		return err
+	}
+
+	cl, err := parseCurrencies(currencySrc)
+	if err != nil {
+		return err
+	}
+
+	constantsSrc, err := os.ReadFile(filepath.Join(dir, "constants.go"))
+	if err != nil {
+		return err
+	}
+
+	codes, err := existingConstants(constantsSrc)
+	if err != nil {
+		return err
+	}
+
+	if iso != nil {
+		n := report(w, cl, iso)
+		if opts.dryRun {
+			return nil
+		}
+
+		if n > maxWithdrawn && !opts.force {
+			return fmt.Errorf("%d currencies would be withdrawn, the list looks truncated, use -force to write anyway", n)
+		}
+
+		cl.update(iso)
+	} else if opts.dryRun {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		seen[code] = true
+	}
+
+	for _, e := range cl.entries {
+		if !seen[e.Code] {
+			codes = append(codes, e.Code)
+			seen[e.Code] = true
+		}
+	}
+	sort.Strings(codes)
+
+	newCurrencySrc, err := cl.source()
+	if err != nil {
+		return err
+	}
+
+	newConstantsSrc, err := constants(codes, cl)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "currency.go"), newCurrencySrc, 0o644); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "constants.go"), newConstantsSrc, 0o644)
+}
+
+// download returns the ISO list published at url.
+func download(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s: %s", url, resp.Status)
+	}
+
+	list, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", url, err)
+	}
+
+	return list, nil
+}
+
+func main() {
+	url := flag.String("url", listURL, "URL of the ISO 4217 list one XML, see -download")
+	xmlPath := flag.String("xml", listPath, "path of the local copy of the ISO 4217 list one XML")
+	fetch := flag.Bool("download", false, "replace the local copy of the ISO list by the one published at -url, unless -n is given")
+	offline := flag.Bool("offline", false, "don't read the ISO list, only rewrite the files from the current currencies")
+	dir := flag.String("dir", ".", "directory of the money package")
+	dryRun := flag.Bool("n", false, "only report the changes, don't write any file")
+	force := flag.Bool("force", false, fmt.Sprintf("write the files even when more than %d currencies are withdrawn", maxWithdrawn))
+	flag.Parse()
+
+	var r io.Reader
+	switch {
+	case *offline:
+	case *fetch:
+		list, err := download(*url)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if !*dryRun {
+			if err := os.WriteFile(*xmlPath, list, 0o644); err != nil {
+				log.Fatal(err)
+			}
+		}
+		r = bytes.NewReader(list)
+	default:
+		f, err := os.Open(*xmlPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if err := run(r, *dir, os.Stdout, options{dryRun: *dryRun, force: *force}); err != nil {
+		log.Fatal(err)
+	}
+}
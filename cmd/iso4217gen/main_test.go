@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCurrencies = `package money
+
+// currencies represents a collection of currency.
+var currencies = Currencies{
+	BYN: {Decimal: ",", Thousand: " ", Code: BYN, Fraction: 2, NumericCode: "933", Grapheme: "p.", Template: "1 $"},
+	BYR: {Decimal: ",", Thousand: " ", Code: BYR, Fraction: 0, NumericCode: "974", Grapheme: "p.", Template: "1 $"},
+	EUR: {Decimal: ".", Thousand: ",", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "€", Template: "$1"},
+	GGP: {Decimal: ".", Thousand: ",", Code: GGP, Fraction: 2, NumericCode: "", Grapheme: "£", Template: "$1"},
+	ISK: {Decimal: ",", Thousand: ".", Code: ISK, Fraction: 2, NumericCode: "352", Grapheme: "kr", Template: "$1", CashIncrement: 100, AccountingFraction: 2, CashFraction: 0},
+	XAU: {Decimal: ".", Thousand: ",", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "XAU", Template: "1 $"},
+}
+
+// AddCurrency lets you insert or update currency in the default Registry.
+func AddCurrency() {}
+`
+
+const testConstants = `package money
+
+// Constants for active currency codes according to the ISO 4217 standard.
+const (
+	BYN = "BYN"
+	BYR = "BYR"
+	EUR = "EUR"
+	GGP = "GGP"
+	ISK = "ISK"
+	XAU = "XAU"
+)
+`
+
+func TestParseList(t *testing.T) {
+	f, err := os.Open("testdata/list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	cs, err := parseList(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 5 {
+		t.Errorf("Expected 5 currencies got %d", len(cs))
+	}
+
+	eur := cs["EUR"]
+	if eur == nil || eur.NumericCode != "978" || eur.Fraction != 2 || eur.Name != "Euro" {
+		t.Errorf("Unexpected EUR %+v", eur)
+	}
+
+	if xau := cs["XAU"]; xau == nil || xau.Fraction != 0 {
+		t.Errorf("Expected XAU without minor units got %+v", xau)
+	}
+
+	if _, err := parseList(strings.NewReader("<ISO_4217></ISO_4217>")); err == nil {
+		t.Error("Expected an error for a list without currencies")
+	}
+
+	if _, err := parseList(strings.NewReader("not xml")); err == nil {
+		t.Error("Expected an error for invalid XML")
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	for name, src := range map[string]string{"currency.go": testCurrencies, "constants.go": testConstants} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open("testdata/list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out bytes.Buffer
+	if err := run(f, dir, &out, options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedReport := `Added: 1
+  SLE 925 (Leone): fill in the plural name, countries, symbols and formatting
+Withdrawn: 1
+  BYR
+Changed: 1
+  ISK: numeric code "352" -> "352", fraction 2 -> 0
+`
+	if out.String() != expectedReport {
+		t.Errorf("Expected report\n%s\ngot\n%s", expectedReport, out.String())
+	}
+
+	currencySrc, err := os.ReadFile(filepath.Join(dir, "currency.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		`	BYR: {Decimal: ",", Thousand: " ", Code: BYR, Fraction: 0, NumericCode: "974", Grapheme: "p.", Template: "1 $", Status: CurrencyWithdrawn},`,
+		`	GGP: {Decimal: ".", Thousand: ",", Code: GGP, Fraction: 2, NumericCode: "", Grapheme: "£", Template: "$1"},`,
+		`	ISK: {Decimal: ",", Thousand: ".", Code: ISK, Fraction: 0, NumericCode: "352", Grapheme: "kr", Template: "$1", CashIncrement: 100, AccountingFraction: 0, CashFraction: 0},`,
+		`	SLE: {Decimal: ".", Thousand: ",", Code: SLE, Fraction: 2, NumericCode: "925", Grapheme: "SLE", Template: "1$", Name: "Leone", AccountingFraction: 2, CashFraction: 2},`,
+		`	XAU: {Decimal: ".", Thousand: ",", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "XAU", Template: "1 $"},`,
+		`func AddCurrency() {}`,
+	} {
+		if !strings.Contains(string(currencySrc), line+"\n") {
+			t.Errorf("Expected currency.go to contain\n%s\ngot\n%s", line, currencySrc)
+		}
+	}
+
+	constantsSrc, err := os.ReadFile(filepath.Join(dir, "constants.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		"// Code generated by iso4217gen; DO NOT EDIT.",
+		"\t// Deprecated: BYR was withdrawn from ISO 4217.\n\tBYR = \"BYR\"",
+		"\tSLE = \"SLE\"",
+	} {
+		if !strings.Contains(string(constantsSrc), line) {
+			t.Errorf("Expected constants.go to contain\n%s\ngot\n%s", line, constantsSrc)
+		}
+	}
+
+	if strings.Contains(string(constantsSrc), "GGP was withdrawn") {
+		t.Errorf("Expected GGP not to be deprecated got\n%s", constantsSrc)
+	}
+}
+
+func TestRun_DryRun(t *testing.T) {
+	dir := t.TempDir()
+	for name, src := range map[string]string{"currency.go": testCurrencies, "constants.go": testConstants} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open("testdata/list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out bytes.Buffer
+	if err := run(f, dir, &out, options{dryRun: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := os.ReadFile(filepath.Join(dir, "currency.go"))
+	if err != nil || string(src) != testCurrencies {
+		t.Errorf("Expected currency.go not to change got %s, %v", src, err)
+	}
+}
+
+func TestRun_Truncated(t *testing.T) {
+	lines := []string{"package money\n\nvar currencies = Currencies{"}
+	for _, code := range []string{"AAA", "BBB", "CCC", "DDD", "EEE", "FFF", "EUR"} {
+		lines = append(lines, fmt.Sprintf("\t%s: {Code: %s, Fraction: 2, NumericCode: \"999\"},", code, code))
+	}
+	lines = append(lines, "}\n")
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{"currency.go": strings.Join(lines, "\n"), "constants.go": testConstants} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, err := os.ReadFile("testdata/list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = run(bytes.NewReader(list), dir, io.Discard, options{})
+	if err == nil || !strings.Contains(err.Error(), "6 currencies would be withdrawn") {
+		t.Errorf("Expected 6 withdrawals to be refused got %v", err)
+	}
+
+	if src, _ := os.ReadFile(filepath.Join(dir, "currency.go")); string(src) != strings.Join(lines, "\n") {
+		t.Errorf("Expected currency.go not to change got %s", src)
+	}
+
+	if err := run(bytes.NewReader(list), dir, io.Discard, options{force: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if src, _ := os.ReadFile(filepath.Join(dir, "currency.go")); !strings.Contains(string(src), "AAA: {Code: AAA, Fraction: 2, NumericCode: \"999\", Status: CurrencyWithdrawn},") {
+		t.Errorf("Expected AAA to be withdrawn with -force got %s", src)
+	}
+}
+
+func TestRun_Offline(t *testing.T) {
+	dir := t.TempDir()
+	currencies := strings.Replace(testCurrencies, `"974", Grapheme: "p.", Template: "1 $"},`, `"974", Grapheme: "p.", Template: "1 $", Status: CurrencyWithdrawn},`, 1)
+	for name, src := range map[string]string{"currency.go": currencies, "constants.go": testConstants} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := run(nil, dir, &out, options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.Len() != 0 {
+		t.Errorf("Expected no report got %s", out.String())
+	}
+
+	if src, err := os.ReadFile(filepath.Join(dir, "currency.go")); err != nil || string(src) != currencies {
+		t.Errorf("Expected currency.go not to change got %s, %v", src, err)
+	}
+
+	constantsSrc, err := os.ReadFile(filepath.Join(dir, "constants.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "// Code generated by iso4217gen; DO NOT EDIT.\n\npackage money\n\n" +
+		"// Constants for currency codes according to the ISO 4217 standard.\nconst (\n" +
+		"\tBYN = \"BYN\"\n\t// Deprecated: BYR was withdrawn from ISO 4217.\n\tBYR = \"BYR\"\n" +
+		"\tEUR = \"EUR\"\n\tGGP = \"GGP\"\n\tISK = \"ISK\"\n\tXAU = \"XAU\"\n)\n"
+	if string(constantsSrc) != expected {
+		t.Errorf("Expected constants.go\n%s\ngot\n%s", expected, constantsSrc)
+	}
+}
+
+func TestListOne(t *testing.T) {
+	f, err := os.Open("list-one.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out bytes.Buffer
+	if err := run(f, filepath.Join("..", ".."), &out, options{dryRun: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "Added: 0\nWithdrawn: 0\nChanged: 0\n"; out.String() != expected {
+		t.Errorf("Expected the checked-in list to match currency.go got %s", out.String())
+	}
+}
@@ -1,6 +1,8 @@
+// Code generated by iso4217gen; DO NOT EDIT.
+
 package money
 
-// Constants for active currency codes according to the ISO 4217 standard.
+// Constants for currency codes according to the ISO 4217 standard.
 const (
 	AED = "AED"
 	AFN = "AFN"
@@ -26,6 +28,7 @@ const (
 	BTN = "BTN"
 	BWP = "BWP"
 	BYN = "BYN"
+	// Deprecated: BYR was withdrawn from ISO 4217.
 	BYR = "BYR"
 	BZD = "BZD"
 	CAD = "CAD"
@@ -44,6 +47,7 @@ const (
 	DKK = "DKK"
 	DOP = "DOP"
 	DZD = "DZD"
+	// Deprecated: EEK was withdrawn from ISO 4217.
 	EEK = "EEK"
 	EGP = "EGP"
 	ERN = "ERN"
@@ -54,6 +58,7 @@ const (
 	GBP = "GBP"
 	GEL = "GEL"
 	GGP = "GGP"
+	// Deprecated: GHC was withdrawn from ISO 4217.
 	GHC = "GHC"
 	GHS = "GHS"
 	GIP = "GIP"
@@ -63,6 +68,7 @@ const (
 	GYD = "GYD"
 	HKD = "HKD"
 	HNL = "HNL"
+	// Deprecated: HRK was withdrawn from ISO 4217.
 	HRK = "HRK"
 	HTG = "HTG"
 	HUF = "HUF"
@@ -91,7 +97,9 @@ const (
 	LKR = "LKR"
 	LRD = "LRD"
 	LSL = "LSL"
+	// Deprecated: LTL was withdrawn from ISO 4217.
 	LTL = "LTL"
+	// Deprecated: LVL was withdrawn from ISO 4217.
 	LVL = "LVL"
 	LYD = "LYD"
 	MAD = "MAD"
@@ -101,8 +109,8 @@ const (
 	MMK = "MMK"
 	MNT = "MNT"
 	MOP = "MOP"
-	MUR = "MUR"
 	MRU = "MRU"
+	MUR = "MUR"
 	MVR = "MVR"
 	MWK = "MWK"
 	MXN = "MXN"
@@ -126,6 +134,7 @@ const (
 	RON = "RON"
 	RSD = "RSD"
 	RUB = "RUB"
+	// Deprecated: RUR was withdrawn from ISO 4217.
 	RUR = "RUR"
 	RWF = "RWF"
 	SAR = "SAR"
@@ -135,12 +144,14 @@ const (
 	SEK = "SEK"
 	SGD = "SGD"
 	SHP = "SHP"
+	// Deprecated: SKK was withdrawn from ISO 4217.
 	SKK = "SKK"
 	SLE = "SLE"
 	SLL = "SLL"
 	SOS = "SOS"
 	SRD = "SRD"
 	SSP = "SSP"
+	// Deprecated: STD was withdrawn from ISO 4217.
 	STD = "STD"
 	STN = "STN"
 	SVC = "SVC"
@@ -151,6 +162,7 @@ const (
 	TMT = "TMT"
 	TND = "TND"
 	TOP = "TOP"
+	// Deprecated: TRL was withdrawn from ISO 4217.
 	TRL = "TRL"
 	TRY = "TRY"
 	TTD = "TTD"
@@ -161,6 +173,7 @@ const (
 	USD = "USD"
 	UYU = "UYU"
 	UZS = "UZS"
+	// Deprecated: VEF was withdrawn from ISO 4217.
 	VEF = "VEF"
 	VES = "VES"
 	VND = "VND"
@@ -176,6 +189,9 @@ const (
 	YER = "YER"
 	ZAR = "ZAR"
 	ZMW = "ZMW"
+	// Deprecated: ZWD was withdrawn from ISO 4217.
 	ZWD = "ZWD"
+	ZWG = "ZWG"
+	// Deprecated: ZWL was withdrawn from ISO 4217.
 	ZWL = "ZWL"
 )
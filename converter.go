@@ -0,0 +1,80 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Converter converts Money into another currency, e.g. using exchange rates.
+type Converter interface {
+	Convert(m *Money, code string) (*Money, error)
+}
+
+// ConverterFunc is an adapter to allow the use of ordinary functions as Converter.
+type ConverterFunc func(m *Money, code string) (*Money, error)
+
+// Convert calls f(m, code).
+func (f ConverterFunc) Convert(m *Money, code string) (*Money, error) {
+	if f == nil {
+		return nil, errors.New("converter function is required to convert")
+	}
+
+	return f(m, code)
+}
+
+// ExchangeRates is a Converter using static rates. It maps currency codes to the value
+// of one major unit of that currency in a common base currency. Results are rounded half to even.
+type ExchangeRates map[string]*big.Rat
+
+// Convert implements Converter.
+func (r ExchangeRates) Convert(m *Money, code string) (*Money, error) {
+	m = m.orZero()
+
+	// The target currency comes from the Registry of the converted Money.
+	to := m.currency.registryOrNil().get(strings.ToUpper(code))
+	if m.currency.equals(to) {
+		return &Money{amount: m.amount, currency: m.currency}, nil
+	}
+
+	fromRate, ok := r[codeOf(m.currency)]
+	if !ok || fromRate == nil || fromRate.Sign() <= 0 {
+		return nil, fmt.Errorf("no exchange rate for %s", codeOf(m.currency))
+	}
+
+	toRate, ok := r[to.Code]
+	if !ok || toRate == nil || toRate.Sign() <= 0 {
+		return nil, fmt.Errorf("no exchange rate for %s", to.Code)
+	}
+
+	// subunits in the target currency = subunits / 10^from.Fraction * fromRate / toRate * 10^to.Fraction
+	toExp, err := pow10Big(to.Fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	fromExp, err := pow10Big(m.currency.get().Fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	f := new(big.Rat).Quo(fromRate, toRate)
+	f.Mul(f, new(big.Rat).SetFrac(toExp, fromExp))
+
+	a, err := mutate.calc.multiplyRat(m.amount, f, RoundHalfEven)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: to}, nil
+}
+
+// pow10Big returns 10^e as big.Int, or ErrInvalidFraction when e is negative or above MaxFraction.
+func pow10Big(e int) (*big.Int, error) {
+	if e < 0 || e > MaxFraction {
+		return nil, ErrInvalidFraction
+	}
+
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(e)), nil), nil
+}
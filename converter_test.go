@@ -0,0 +1,70 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestExchangeRates_Convert(t *testing.T) {
+	rates := ExchangeRates{
+		USD: big.NewRat(1, 1),
+		EUR: big.NewRat(10834, 10000),
+		JPY: big.NewRat(1, 150),
+		BHD: big.NewRat(265, 100),
+	}
+
+	tcs := []struct {
+		amount   int64
+		from     string
+		to       string
+		expected int64
+	}{
+		{10000, EUR, USD, 10834},
+		{10834, USD, EUR, 10000},
+		{100, USD, JPY, 150},
+		{15000, JPY, USD, 10000},
+		{1000, BHD, USD, 265},
+		{265, USD, BHD, 1000},
+		{-10000, EUR, USD, -10834},
+		{10000, EUR, EUR, 10000},
+		// 0.005 USD is exactly half a cent, ties round to even.
+		{1, JPY, USD, 1},
+		{3, JPY, USD, 2},
+	}
+
+	for _, tc := range tcs {
+		r, err := rates.Convert(New(tc.amount, tc.from), tc.to)
+		if err != nil {
+			t.Errorf("Unexpected error converting %d %s to %s: %v", tc.amount, tc.from, tc.to, err)
+			continue
+		}
+
+		if r.amount != tc.expected || r.currency.Code != tc.to {
+			t.Errorf("Expected %d %s to be %d %s got %d %s", tc.amount, tc.from, tc.expected, tc.to,
+				r.amount, r.currency.Code)
+		}
+	}
+
+	if _, err := rates.Convert(New(100, GBP), USD); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := rates.Convert(New(100, USD), GBP); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestConverterFunc(t *testing.T) {
+	c := ConverterFunc(func(m *Money, code string) (*Money, error) {
+		return New(m.Amount()*2, code), nil
+	})
+
+	r, err := c.Convert(New(100, EUR), USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.amount != 200 || r.currency.Code != USD {
+		t.Errorf("Expected %d %s got %d %s", 200, USD, r.amount, r.currency.Code)
+	}
+}
@@ -0,0 +1,186 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Codes of the currencies of the crypto pack. They aren't part of ISO 4217,
+// so they're only known by a Registry after AddCrypto.
+const (
+	BTC  = "BTC"
+	ETH  = "ETH"
+	SOL  = "SOL"
+	USDC = "USDC"
+	USDT = "USDT"
+)
+
+// Unit is an alternate unit of a currency, such as sat for BTC or gwei for ETH.
+type Unit struct {
+	// Name of the unit, used both to look it up and as its symbol when displayed.
+	Name string
+	// Exponent is the number of subunits of the currency in one unit as a power of ten,
+	// e.g. 9 for gwei which is 10^9 wei. The subunit itself, such as sat or wei, has exponent 0.
+	Exponent int
+}
+
+// CryptoCurrencies returns new copies of the currencies of the crypto pack, with their on-chain decimals.
+// ETH amounts overflow Money above about 9.2 ETH, use BigMoney for them.
+func CryptoCurrencies() []*Currency {
+	cs := []*Currency{
+		{Code: BTC, Fraction: 8, Grapheme: "₿", Template: "$1", Decimal: ".", Thousand: ",",
+			Name: "Bitcoin", PluralName: "bitcoins", Symbol: BTC, NarrowSymbol: "₿",
+			meta: &currencyMeta{units: []Unit{{Name: "sat", Exponent: 0}, {Name: "mBTC", Exponent: 5}}}},
+		{Code: ETH, Fraction: 18, Grapheme: "Ξ", Template: "$1", Decimal: ".", Thousand: ",",
+			Name: "Ether", PluralName: "ether", Symbol: ETH, NarrowSymbol: "Ξ",
+			meta: &currencyMeta{units: []Unit{{Name: "wei", Exponent: 0}, {Name: "gwei", Exponent: 9}}}},
+		{Code: SOL, Fraction: 9, Grapheme: "◎", Template: "$1", Decimal: ".", Thousand: ",",
+			Name: "Solana", PluralName: "Solana", Symbol: SOL, NarrowSymbol: "◎",
+			meta: &currencyMeta{units: []Unit{{Name: "lamport", Exponent: 0}}}},
+		{Code: USDC, Fraction: 6, Grapheme: USDC, Template: "1 $", Decimal: ".", Thousand: ",",
+			Name: "USD Coin", PluralName: "USD Coins", Symbol: USDC, NarrowSymbol: "$"},
+		{Code: USDT, Fraction: 6, Grapheme: USDT, Template: "1 $", Decimal: ".", Thousand: ",",
+			Name: "Tether", PluralName: "Tether", Symbol: USDT, NarrowSymbol: "$"},
+	}
+
+	for _, c := range cs {
+		c.AccountingFraction = c.Fraction
+		c.CashFraction = c.Fraction
+	}
+
+	return cs
+}
+
+// AddCrypto adds the currencies of the crypto pack to the Registry and returns it.
+// The pack is opt-in, e.g. money.DefaultRegistry().AddCrypto() or NewRegistry().AddCrypto().
+func (r *Registry) AddCrypto() *Registry {
+	for _, c := range CryptoCurrencies() {
+		r = r.Add(c)
+	}
+
+	return r
+}
+
+// Unit returns the unit of the currency given its name, nil if there's none.
+// The currency code is a unit too, its exponent is the fraction, e.g. BTC for 10^8 sat.
+func (c *Currency) Unit(name string) *Unit {
+	c = c.get()
+	if name == c.Code {
+		return &Unit{Name: c.Code, Exponent: c.Fraction}
+	}
+
+	for _, u := range c.Units() {
+		if u.Name == name {
+			return &Unit{Name: u.Name, Exponent: u.Exponent}
+		}
+	}
+
+	return nil
+}
+
+// unit returns the unit of the currency given its name, or an error naming the operation.
+func (c *Currency) unit(name, op string) (*Unit, error) {
+	u := c.Unit(name)
+	if u == nil {
+		return nil, &ParseError{Op: op, Input: name, Err: fmt.Errorf("unknown unit of %s", c.get().Code)}
+	}
+
+	return u, nil
+}
+
+// In returns the exact value of BigMoney in the given unit, e.g. "gwei" or "ETH".
+func (m *BigMoney) In(unit string) (*big.Rat, error) {
+	u, err := m.Currency().unit(unit, "In")
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := pow10Big(u.Exponent)
+	if err != nil {
+		return nil, &ParseError{Op: "In", Input: unit, Err: err}
+	}
+
+	return new(big.Rat).SetFrac(m.value(), exp), nil
+}
+
+// DisplayIn lets represent BigMoney as string in the given unit, e.g. 1.5 gwei,
+// with the separators of its currency and without losing any digit.
+func (m *BigMoney) DisplayIn(unit string) (string, error) {
+	c := m.Currency().get()
+	u, err := c.unit(unit, "DisplayIn")
+	if err != nil {
+		return "", err
+	}
+
+	if u.Name == c.Code {
+		return m.Display(), nil
+	}
+
+	f := NewFormatter(u.Exponent, c.Decimal, c.Thousand, u.Name, "1 $")
+	return f.FormatBig(m.value()), nil
+}
+
+// AsMajorUnitsRat returns the exact value of BigMoney in major units, unlike AsMajorUnits.
+func (m *BigMoney) AsMajorUnitsRat() *big.Rat {
+	return m.Currency().Formatter().ToMajorUnitsRat(m.value())
+}
+
+// ParseBig parses a decimal amount of major units, such as "1.000000000000000001", into BigMoney
+// of a currency of the Registry without losing any digit. It fails like Parse, but never overflows.
+func (r *Registry) ParseBig(amount, code string) (*BigMoney, error) {
+	return r.parseBig(amount, code, "", "ParseBig")
+}
+
+// ParseBigUnit parses a decimal amount of the given unit of the currency, such as "1.5" gwei,
+// into BigMoney without losing any digit.
+func (r *Registry) ParseBigUnit(amount, code, unit string) (*BigMoney, error) {
+	return r.parseBig(amount, code, unit, "ParseBigUnit")
+}
+
+func (r *Registry) parseBig(amount, code, unit, op string) (*BigMoney, error) {
+	c, err := r.current(code, op)
+	if err != nil {
+		return nil, err
+	}
+
+	exp := c.Fraction
+	if unit != "" {
+		u, err := c.unit(unit, op)
+		if err != nil {
+			return nil, err
+		}
+
+		exp = u.Exponent
+	}
+
+	d, err := parseDecimal(amount)
+	if err != nil {
+		return nil, &ParseError{Op: op, Input: amount, Err: err}
+	}
+
+	p, err := pow10Big(exp)
+	if err != nil {
+		return nil, &ParseError{Op: op, Input: amount, Err: err}
+	}
+
+	d.Mul(d, new(big.Rat).SetInt(p))
+
+	if !d.IsInt() {
+		return nil, &ParseError{Op: op, Input: amount, Err: errors.New("amount isn't a whole number of subunits")}
+	}
+
+	return &BigMoney{amount: new(big.Int).Set(d.Num()), currency: c}, nil
+}
+
+// ParseBig parses a decimal amount of major units into BigMoney of a currency of the default Registry,
+// see Registry.ParseBig.
+func ParseBig(amount, code string) (*BigMoney, error) {
+	return defaultRegistry.ParseBig(amount, code)
+}
+
+// ParseBigUnit parses a decimal amount of the given unit into BigMoney of a currency of the default Registry,
+// see Registry.ParseBigUnit.
+func ParseBigUnit(amount, code, unit string) (*BigMoney, error) {
+	return defaultRegistry.ParseBigUnit(amount, code, unit)
+}
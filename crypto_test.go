@@ -0,0 +1,185 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestRegistry_AddCrypto(t *testing.T) {
+	if DefaultRegistry().Clone().CurrencyByCode(ETH) != nil {
+		t.Fatal("Expected the crypto pack to be opt-in")
+	}
+
+	r := DefaultRegistry().Clone().AddCrypto()
+
+	tcs := []struct {
+		code     string
+		fraction int
+	}{
+		{BTC, 8},
+		{ETH, 18},
+		{SOL, 9},
+		{USDC, 6},
+		{USDT, 6},
+	}
+
+	for _, tc := range tcs {
+		c := r.CurrencyByCode(tc.code)
+		if c == nil || c.Fraction != tc.fraction {
+			t.Errorf("Expected %s with fraction %d got %v", tc.code, tc.fraction, c)
+		}
+	}
+
+	if r.CurrencyByCode(EUR) == nil {
+		t.Error("Expected the ISO currencies to be kept")
+	}
+
+	if r.New(150000000, BTC).Display() != "₿1.50000000" {
+		t.Errorf("Expected ₿1.50000000 got %s", r.New(150000000, BTC).Display())
+	}
+}
+
+func TestCurrency_Units(t *testing.T) {
+	r := NewRegistry().AddCrypto()
+	if us := r.CurrencyByCode(ETH).Units(); len(us) != 2 || us[1] != (Unit{Name: "gwei", Exponent: 9}) {
+		t.Errorf("Expected wei and gwei got %v", us)
+	}
+
+	// The units of a registered currency can't be changed through the returned slice.
+	r.CurrencyByCode(ETH).Units()[1].Exponent = 3
+	if u := r.CurrencyByCode(ETH).Unit("gwei"); u == nil || u.Exponent != 9 {
+		t.Errorf("Expected gwei with exponent 9 got %v", u)
+	}
+
+	abc := (&Currency{Code: "ABC", Fraction: 6}).WithUnits(Unit{Name: "milli", Exponent: 3})
+	r.Add(abc)
+	if u := r.CurrencyByCode("ABC").Unit("milli"); u == nil || u.Exponent != 3 {
+		t.Errorf("Expected milli with exponent 3 got %v", u)
+	}
+
+	if us := (*Currency)(nil).Units(); us != nil {
+		t.Errorf("Expected no units got %v", us)
+	}
+}
+
+func TestBigMoney_DisplayIn(t *testing.T) {
+	r := NewRegistry().AddCrypto()
+	wei, _ := new(big.Int).SetString("1234500000001500000000", 10)
+
+	tcs := []struct {
+		amount   *big.Int
+		code     string
+		unit     string
+		expected string
+	}{
+		{wei, ETH, ETH, "Ξ1,234.500000001500000000"},
+		{wei, ETH, "gwei", "1,234,500,000,001.500000000 gwei"},
+		{wei, ETH, "wei", "1,234,500,000,001,500,000,000 wei"},
+		{big.NewInt(-150000), BTC, "sat", "-150,000 sat"},
+		{big.NewInt(150000), BTC, "mBTC", "1.50000 mBTC"},
+		{big.NewInt(1), SOL, "lamport", "1 lamport"},
+	}
+
+	for _, tc := range tcs {
+		m := r.NewBig(tc.amount, tc.code)
+		d, err := m.DisplayIn(tc.unit)
+		if err != nil || d != tc.expected {
+			t.Errorf("Expected %s got %s %v", tc.expected, d, err)
+		}
+	}
+
+	if _, err := r.NewBig(wei, USDC).DisplayIn("gwei"); err == nil {
+		t.Error("Expected error for a unit of another currency")
+	}
+}
+
+func TestBigMoney_In(t *testing.T) {
+	r := NewRegistry().AddCrypto()
+	wei, _ := new(big.Int).SetString("1000000000000000001", 10)
+	m := r.NewBig(wei, ETH)
+
+	eth, err := m.In(ETH)
+	if err != nil || eth.Cmp(big.NewRat(1, 1)) <= 0 || eth.FloatString(18) != "1.000000000000000001" {
+		t.Errorf("Expected 1.000000000000000001 got %v %v", eth, err)
+	}
+
+	if m.AsMajorUnitsRat().Cmp(eth) != 0 {
+		t.Errorf("Expected AsMajorUnitsRat to equal In(ETH) got %v", m.AsMajorUnitsRat())
+	}
+
+	if m.AsMajorUnits() != 1 {
+		t.Errorf("Expected float64 to round to 1 got %v", m.AsMajorUnits())
+	}
+
+	gwei, err := m.In("gwei")
+	if err != nil || gwei.FloatString(9) != "1000000000.000000001" {
+		t.Errorf("Expected 1000000000.000000001 got %v %v", gwei, err)
+	}
+
+	if _, err := m.In("sat"); err == nil {
+		t.Error("Expected error for an unknown unit")
+	}
+}
+
+func TestRegistry_ParseBig(t *testing.T) {
+	r := NewRegistry().AddCrypto()
+
+	tcs := []struct {
+		amount   string
+		code     string
+		unit     string
+		expected string
+		err      error
+	}{
+		{"1.000000000000000001", ETH, "", "1000000000000000001", nil},
+		{"123456789.5", ETH, "", "123456789500000000000000000", nil},
+		{"1.5", ETH, "gwei", "1500000000", nil},
+		{"0.5", ETH, "wei", "", errors.New("subunits")},
+		{"0.00000001", BTC, "", "1", nil},
+		{"-2.5", BTC, "mBTC", "-250000", nil},
+		{"1.5", BTC, "gwei", "", errors.New("unit")},
+		{"1", "DOGE", "", "", ErrUnknownCurrency},
+		{"1e3", SOL, "", "", errors.New("decimal")},
+	}
+
+	for _, tc := range tcs {
+		var m *BigMoney
+		var err error
+		if tc.unit == "" {
+			m, err = r.ParseBig(tc.amount, tc.code)
+		} else {
+			m, err = r.ParseBigUnit(tc.amount, tc.code, tc.unit)
+		}
+
+		if tc.err != nil {
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("Expected ParseError for %s %s got %v", tc.amount, tc.unit, err)
+			}
+
+			if tc.err == ErrUnknownCurrency && !errors.Is(err, ErrUnknownCurrency) {
+				t.Errorf("Expected ErrUnknownCurrency got %v", err)
+			}
+
+			continue
+		}
+
+		if err != nil || m.Amount().String() != tc.expected || m.Currency().Code != tc.code {
+			t.Errorf("Expected %s %s got %v %v", tc.expected, tc.code, m, err)
+		}
+	}
+}
+
+func TestFormatter_ToMajorUnitsRat(t *testing.T) {
+	f := NewFormatter(18, ".", ",", "Ξ", "$1")
+	a, _ := new(big.Int).SetString("123456789123456789123456789", 10)
+
+	if r := f.ToMajorUnitsRat(a); r.FloatString(18) != "123456789.123456789123456789" {
+		t.Errorf("Expected 123456789.123456789123456789 got %s", r.FloatString(18))
+	}
+
+	if r := f.ToMajorUnitsRat(nil); r.Sign() != 0 {
+		t.Errorf("Expected 0 got %s", r)
+	}
+}
@@ -1,9 +1,17 @@
 package money
 
 import (
+	"fmt"
+	"math"
 	"strings"
+	"time"
 )
 
+// The currency constants, numeric codes and fractions are updated from the checked-in ISO 4217 list one,
+// see cmd/iso4217gen for downloading the published list. The currency tags follow the constants.
+//go:generate go run ./cmd/iso4217gen
+//go:generate go generate ./tag
+
 // Currency represents money currency information required for formatting.
 type Currency struct {
 	Code        string
@@ -13,14 +21,118 @@ type Currency struct {
 	Template    string
 	Decimal     string
 	Thousand    string
+	// Name is the English name of the currency, e.g. "US Dollar".
+	Name string
+	// PluralName is the English name of an amount of the currency, e.g. "US dollars".
+	PluralName string
+	// Symbol tells the currency apart from the ones sharing its narrow symbol, e.g. "US$" or "CA$".
+	Symbol string
+	// NarrowSymbol is the shortest symbol of the currency, e.g. "$".
+	NarrowSymbol string
+	// AccountingFraction is the number of decimals amounts are kept and settled with electronically,
+	// which can be below the ISO 4217 Fraction, e.g. 0 for IQD. See Money.RoundToAccounting.
+	// When both AccountingFraction and CashFraction are zero while Fraction isn't, they're taken as unset:
+	// adding the currency to a Registry sets them to the ones of the built-in currency with the same code
+	// and fraction, or else to Fraction.
+	AccountingFraction int
+	// CashFraction is the number of decimals of the smallest coin in circulation,
+	// e.g. 0 for HUF whose fillér coins were withdrawn. See Money.RoundToCash.
+	CashFraction int
+	// CashIncrement is the smallest amount in subunits that can be paid in cash when the smallest coin
+	// isn't a power of ten, e.g. 5 for CHF where it's 0.05. It has to be a multiple of the subunits of
+	// CashFraction. Zero means the smallest coin is given by CashFraction.
+	CashIncrement int64
+	// Status tells whether the currency is still in use, see ValidTo for when it was withdrawn.
+	Status CurrencyStatus
+	// ValidFrom is the first day the currency is in use, zero if unknown or before 1970.
+	ValidFrom time.Time
+	// ValidTo is the first day the currency is no longer in use, zero while it's in use.
+	ValidTo time.Time
+
+	// meta holds the data which isn't comparable, such as the countries, so Currency stays comparable.
+	// It's never changed once set, see the With methods.
+	meta *currencyMeta
+	// registry the currency belongs to, nil for the default Registry.
+	registry *Registry
+}
+
+// currencyMeta is the data of a currency which isn't comparable.
+type currencyMeta struct {
+	countries []string
+	units     []Unit
+	exponents map[string]int
+}
+
+// clone returns a deep copy of the data, so currencies copied into a Registry don't share it.
+func (m *currencyMeta) clone() *currencyMeta {
+	if m == nil {
+		return nil
+	}
+
+	cm := &currencyMeta{
+		countries: append([]string(nil), m.countries...),
+		units:     append([]Unit(nil), m.units...),
+	}
+
+	if m.exponents != nil {
+		cm.exponents = make(map[string]int, len(m.exponents))
+		for scheme, e := range m.exponents {
+			cm.exponents[scheme] = e
+		}
+	}
+
+	return cm
 }
 
+// MaxFraction is the largest Fraction of a currency, enough for tokens with 18 decimals and more.
+// Registries reject currencies with a larger or negative fraction or exponent, see Registry.Register.
+const MaxFraction = 36
+
+// validFractions returns ErrInvalidFraction when a fraction or exponent of c is negative or above MaxFraction.
+func validFractions(c *Currency) error {
+	es := []int{c.Fraction, c.AccountingFraction, c.CashFraction}
+	for _, u := range c.Units() {
+		es = append(es, u.Exponent)
+	}
+
+	if c.meta != nil {
+		for _, e := range c.meta.exponents {
+			es = append(es, e)
+		}
+	}
+
+	for _, e := range es {
+		if e < 0 || e > MaxFraction {
+			return fmt.Errorf("%w: %d for %s", ErrInvalidFraction, e, c.Code)
+		}
+	}
+
+	return nil
+}
+
+// CurrencyStatus tells whether a currency is still in use.
+type CurrencyStatus int
+
+const (
+	// CurrencyActive is the status of a currency in use, the zero value.
+	CurrencyActive CurrencyStatus = iota
+	// CurrencyWithdrawn is the status of a currency which is no longer in use,
+	// it's kept so historical records can still be read.
+	CurrencyWithdrawn
+)
+
 type Currencies map[string]*Currency
 
 // CurrencyByNumericCode returns the currency given the numeric code defined in ISO-4271.
+// It scans the whole list, Registry.CurrencyByNumericCode uses an index.
 func (c Currencies) CurrencyByNumericCode(code string) *Currency {
+	code = normalizeNumericCode(code)
+	if code == "" {
+		return nil
+	}
+
 	for _, sc := range c {
-		if sc.NumericCode == code {
+		if sc != nil && normalizeNumericCode(sc.NumericCode) == code {
 			return sc
 		}
 	}
@@ -39,217 +151,252 @@ func (c Currencies) CurrencyByCode(code string) *Currency {
 }
 
 // Add updates currencies list by adding a given Currency to it.
+// A nil currency is ignored.
 func (c Currencies) Add(currency *Currency) Currencies {
+	if currency == nil {
+		return c
+	}
+
+	if c == nil {
+		c = Currencies{}
+	}
+
 	c[currency.Code] = currency
 	return c
 }
 
+// day returns the UTC midnight of a date of the currency list.
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
 // currencies represents a collection of currency.
+// Names, symbols and the accounting and cash fractions follow the English data of the Unicode CLDR,
+// countries follow ISO 4217.
 var currencies = Currencies{
-	AED: {Decimal: ".", Thousand: ",", Code: AED, Fraction: 2, NumericCode: "784", Grapheme: ".\u062f.\u0625", Template: "1 $"},
-	AFN: {Decimal: ".", Thousand: ",", Code: AFN, Fraction: 2, NumericCode: "971", Grapheme: "\u060b", Template: "1 $"},
-	ALL: {Decimal: ".", Thousand: ",", Code: ALL, Fraction: 2, NumericCode: "008", Grapheme: "L", Template: "$1"},
-	AMD: {Decimal: ".", Thousand: ",", Code: AMD, Fraction: 2, NumericCode: "051", Grapheme: "\u0564\u0580.", Template: "1 $"},
-	ANG: {Decimal: ",", Thousand: ".", Code: ANG, Fraction: 2, NumericCode: "532", Grapheme: "\u0192", Template: "$1"},
-	AOA: {Decimal: ".", Thousand: ",", Code: AOA, Fraction: 2, NumericCode: "973", Grapheme: "Kz", Template: "1$"},
-	ARS: {Decimal: ",", Thousand: ".", Code: ARS, Fraction: 2, NumericCode: "032", Grapheme: "$", Template: "$1"},
-	AUD: {Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "$", Template: "$1"},
-	AWG: {Decimal: ".", Thousand: ",", Code: AWG, Fraction: 2, NumericCode: "533", Grapheme: "\u0192", Template: "1$"},
-	AZN: {Decimal: ".", Thousand: ",", Code: AZN, Fraction: 2, NumericCode: "944", Grapheme: "\u20bc", Template: "$1"},
-	BAM: {Decimal: ".", Thousand: ",", Code: BAM, Fraction: 2, NumericCode: "977", Grapheme: "KM", Template: "$1"},
-	BBD: {Decimal: ".", Thousand: ",", Code: BBD, Fraction: 2, NumericCode: "052", Grapheme: "$", Template: "$1"},
-	BDT: {Decimal: ".", Thousand: ",", Code: BDT, Fraction: 2, NumericCode: "050", Grapheme: "\u09f3", Template: "$1"},
-	BGN: {Decimal: ".", Thousand: ",", Code: BGN, Fraction: 2, NumericCode: "975", Grapheme: "\u043b\u0432", Template: "$1"},
-	BHD: {Decimal: ".", Thousand: ",", Code: BHD, Fraction: 3, NumericCode: "048", Grapheme: ".\u062f.\u0628", Template: "1 $"},
-	BIF: {Decimal: ".", Thousand: ",", Code: BIF, Fraction: 0, NumericCode: "108", Grapheme: "Fr", Template: "1$"},
-	BMD: {Decimal: ".", Thousand: ",", Code: BMD, Fraction: 2, NumericCode: "060", Grapheme: "$", Template: "$1"},
-	BND: {Decimal: ".", Thousand: ",", Code: BND, Fraction: 2, NumericCode: "096", Grapheme: "$", Template: "$1"},
-	BOB: {Decimal: ".", Thousand: ",", Code: BOB, Fraction: 2, NumericCode: "068", Grapheme: "Bs.", Template: "$1"},
-	BRL: {Decimal: ",", Thousand: ".", Code: BRL, Fraction: 2, NumericCode: "986", Grapheme: "R$", Template: "$1"},
-	BSD: {Decimal: ".", Thousand: ",", Code: BSD, Fraction: 2, NumericCode: "044", Grapheme: "$", Template: "$1"},
-	BTN: {Decimal: ".", Thousand: ",", Code: BTN, Fraction: 2, NumericCode: "064", Grapheme: "Nu.", Template: "1$"},
-	BWP: {Decimal: ".", Thousand: ",", Code: BWP, Fraction: 2, NumericCode: "072", Grapheme: "P", Template: "$1"},
-	BYN: {Decimal: ",", Thousand: " ", Code: BYN, Fraction: 2, NumericCode: "933", Grapheme: "p.", Template: "1 $"},
-	BYR: {Decimal: ",", Thousand: " ", Code: BYR, Fraction: 0, NumericCode: "", Grapheme: "p.", Template: "1 $"},
-	BZD: {Decimal: ".", Thousand: ",", Code: BZD, Fraction: 2, NumericCode: "084", Grapheme: "BZ$", Template: "$1"},
-	CAD: {Decimal: ".", Thousand: ",", Code: CAD, Fraction: 2, NumericCode: "124", Grapheme: "$", Template: "$1"},
-	CDF: {Decimal: ".", Thousand: ",", Code: CDF, Fraction: 2, NumericCode: "976", Grapheme: "FC", Template: "1$"},
-	CHF: {Decimal: ".", Thousand: ",", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Template: "1 $"},
-	CLF: {Decimal: ",", Thousand: ".", Code: CLF, Fraction: 4, NumericCode: "990", Grapheme: "UF", Template: "$1"},
-	CLP: {Decimal: ",", Thousand: ".", Code: CLP, Fraction: 0, NumericCode: "152", Grapheme: "$", Template: "$1"},
-	CNY: {Decimal: ".", Thousand: ",", Code: CNY, Fraction: 2, NumericCode: "156", Grapheme: "\u5143", Template: "1 $"},
-	COP: {Decimal: ",", Thousand: ".", Code: COP, Fraction: 2, NumericCode: "170", Grapheme: "$", Template: "$1"},
-	CRC: {Decimal: ".", Thousand: ",", Code: CRC, Fraction: 2, NumericCode: "188", Grapheme: "\u20a1", Template: "$1"},
-	CUC: {Decimal: ".", Thousand: ",", Code: CUC, Fraction: 2, NumericCode: "931", Grapheme: "$", Template: "1$"},
-	CUP: {Decimal: ".", Thousand: ",", Code: CUP, Fraction: 2, NumericCode: "192", Grapheme: "$MN", Template: "$1"},
-	CVE: {Decimal: ".", Thousand: ",", Code: CVE, Fraction: 2, NumericCode: "132", Grapheme: "$", Template: "1$"},
-	CZK: {Decimal: ".", Thousand: ",", Code: CZK, Fraction: 2, NumericCode: "203", Grapheme: "K\u010d", Template: "1 $"},
-	DJF: {Decimal: ".", Thousand: ",", Code: DJF, Fraction: 0, NumericCode: "262", Grapheme: "Fdj", Template: "1 $"},
-	DKK: {Decimal: ",", Thousand: ".", Code: DKK, Fraction: 2, NumericCode: "208", Grapheme: "kr", Template: "$ 1"},
-	DOP: {Decimal: ".", Thousand: ",", Code: DOP, Fraction: 2, NumericCode: "214", Grapheme: "RD$", Template: "$1"},
-	DZD: {Decimal: ".", Thousand: ",", Code: DZD, Fraction: 2, NumericCode: "012", Grapheme: ".\u062f.\u062c", Template: "1 $"},
-	EEK: {Decimal: ".", Thousand: ",", Code: EEK, Fraction: 2, NumericCode: "", Grapheme: "kr", Template: "$1"},
-	EGP: {Decimal: ".", Thousand: ",", Code: EGP, Fraction: 2, NumericCode: "818", Grapheme: "\u00a3", Template: "$1"},
-	ERN: {Decimal: ".", Thousand: ",", Code: ERN, Fraction: 2, NumericCode: "232", Grapheme: "Nfk", Template: "1 $"},
-	ETB: {Decimal: ".", Thousand: ",", Code: ETB, Fraction: 2, NumericCode: "230", Grapheme: "Br", Template: "1 $"},
-	EUR: {Decimal: ".", Thousand: ",", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "\u20ac", Template: "$1"},
-	FJD: {Decimal: ".", Thousand: ",", Code: FJD, Fraction: 2, NumericCode: "242", Grapheme: "$", Template: "$1"},
-	FKP: {Decimal: ".", Thousand: ",", Code: FKP, Fraction: 2, NumericCode: "238", Grapheme: "\u00a3", Template: "$1"},
-	GBP: {Decimal: ".", Thousand: ",", Code: GBP, Fraction: 2, NumericCode: "826", Grapheme: "\u00a3", Template: "$1"},
-	GEL: {Decimal: ".", Thousand: ",", Code: GEL, Fraction: 2, NumericCode: "981", Grapheme: "\u10da", Template: "1 $"},
-	GGP: {Decimal: ".", Thousand: ",", Code: GGP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1"},
-	GHC: {Decimal: ".", Thousand: ",", Code: GHC, Fraction: 2, NumericCode: "", Grapheme: "\u00a2", Template: "$1"},
-	GHS: {Decimal: ".", Thousand: ",", Code: GHS, Fraction: 2, NumericCode: "936", Grapheme: "\u20b5", Template: "$1"},
-	GIP: {Decimal: ".", Thousand: ",", Code: GIP, Fraction: 2, NumericCode: "292", Grapheme: "\u00a3", Template: "$1"},
-	GMD: {Decimal: ".", Thousand: ",", Code: GMD, Fraction: 2, NumericCode: "270", Grapheme: "D", Template: "1 $"},
-	GNF: {Decimal: ".", Thousand: ",", Code: GNF, Fraction: 0, NumericCode: "324", Grapheme: "FG", Template: "1 $"},
-	GTQ: {Decimal: ".", Thousand: ",", Code: GTQ, Fraction: 2, NumericCode: "320", Grapheme: "Q", Template: "$1"},
-	GYD: {Decimal: ".", Thousand: ",", Code: GYD, Fraction: 2, NumericCode: "328", Grapheme: "$", Template: "$1"},
-	HKD: {Decimal: ".", Thousand: ",", Code: HKD, Fraction: 2, NumericCode: "344", Grapheme: "$", Template: "$1"},
-	HNL: {Decimal: ".", Thousand: ",", Code: HNL, Fraction: 2, NumericCode: "340", Grapheme: "L", Template: "$1"},
-	HRK: {Decimal: ",", Thousand: ".", Code: HRK, Fraction: 2, NumericCode: "191", Grapheme: "kn", Template: "1 $"},
-	HTG: {Decimal: ",", Thousand: ".", Code: HTG, Fraction: 2, NumericCode: "332", Grapheme: "G", Template: "1 $"},
-	HUF: {Decimal: ",", Thousand: ".", Code: HUF, Fraction: 2, NumericCode: "348", Grapheme: "Ft", Template: "1 $"},
-	IDR: {Decimal: ",", Thousand: ".", Code: IDR, Fraction: 2, NumericCode: "360", Grapheme: "Rp", Template: "$1"},
-	ILS: {Decimal: ".", Thousand: ",", Code: ILS, Fraction: 2, NumericCode: "376", Grapheme: "\u20aa", Template: "$1"},
-	IMP: {Decimal: ".", Thousand: ",", Code: IMP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1"},
-	INR: {Decimal: ".", Thousand: ",", Code: INR, Fraction: 2, NumericCode: "356", Grapheme: "\u20b9", Template: "$1"},
-	IQD: {Decimal: ".", Thousand: ",", Code: IQD, Fraction: 3, NumericCode: "368", Grapheme: ".\u062f.\u0639", Template: "1 $"},
-	IRR: {Decimal: ".", Thousand: ",", Code: IRR, Fraction: 2, NumericCode: "364", Grapheme: "\ufdfc", Template: "1 $"},
-	ISK: {Decimal: ",", Thousand: ".", Code: ISK, Fraction: 0, NumericCode: "352", Grapheme: "kr", Template: "$1"},
-	JEP: {Decimal: ".", Thousand: ",", Code: JEP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1"},
-	JMD: {Decimal: ".", Thousand: ",", Code: JMD, Fraction: 2, NumericCode: "388", Grapheme: "J$", Template: "$1"},
-	JOD: {Decimal: ".", Thousand: ",", Code: JOD, Fraction: 3, NumericCode: "400", Grapheme: ".\u062f.\u0625", Template: "1 $"},
-	JPY: {Decimal: ".", Thousand: ",", Code: JPY, Fraction: 0, NumericCode: "392", Grapheme: "\u00a5", Template: "$1"},
-	KES: {Decimal: ".", Thousand: ",", Code: KES, Fraction: 2, NumericCode: "404", Grapheme: "KSh", Template: "$1"},
-	KGS: {Decimal: ".", Thousand: ",", Code: KGS, Fraction: 2, NumericCode: "417", Grapheme: "\u0441\u043e\u043c", Template: "1 $"},
-	KHR: {Decimal: ".", Thousand: ",", Code: KHR, Fraction: 2, NumericCode: "116", Grapheme: "\u17db", Template: "$1"},
-	KMF: {Decimal: ".", Thousand: ",", Code: KMF, Fraction: 0, NumericCode: "174", Grapheme: "CF", Template: "$1"},
-	KPW: {Decimal: ".", Thousand: ",", Code: KPW, Fraction: 2, NumericCode: "408", Grapheme: "\u20a9", Template: "$1"},
-	KRW: {Decimal: ".", Thousand: ",", Code: KRW, Fraction: 0, NumericCode: "410", Grapheme: "\u20a9", Template: "$1"},
-	KWD: {Decimal: ".", Thousand: ",", Code: KWD, Fraction: 3, NumericCode: "414", Grapheme: ".\u062f.\u0643", Template: "1 $"},
-	KYD: {Decimal: ".", Thousand: ",", Code: KYD, Fraction: 2, NumericCode: "136", Grapheme: "$", Template: "$1"},
-	KZT: {Decimal: ".", Thousand: ",", Code: KZT, Fraction: 2, NumericCode: "398", Grapheme: "\u20b8", Template: "$1"},
-	LAK: {Decimal: ".", Thousand: ",", Code: LAK, Fraction: 2, NumericCode: "418", Grapheme: "\u20ad", Template: "$1"},
-	LBP: {Decimal: ".", Thousand: ",", Code: LBP, Fraction: 2, NumericCode: "422", Grapheme: "\u00a3", Template: "$1"},
-	LKR: {Decimal: ".", Thousand: ",", Code: LKR, Fraction: 2, NumericCode: "144", Grapheme: "\u20a8", Template: "$1"},
-	LRD: {Decimal: ".", Thousand: ",", Code: LRD, Fraction: 2, NumericCode: "430", Grapheme: "$", Template: "$1"},
-	LSL: {Decimal: ".", Thousand: ",", Code: LSL, Fraction: 2, NumericCode: "426", Grapheme: "L", Template: "$1"},
-	LTL: {Decimal: ".", Thousand: ",", Code: LTL, Fraction: 2, NumericCode: "", Grapheme: "Lt", Template: "$1"},
-	LVL: {Decimal: ".", Thousand: ",", Code: LVL, Fraction: 2, NumericCode: "", Grapheme: "Ls", Template: "1 $"},
-	LYD: {Decimal: ".", Thousand: ",", Code: LYD, Fraction: 3, NumericCode: "434", Grapheme: ".\u062f.\u0644", Template: "1 $"},
-	MAD: {Decimal: ".", Thousand: ",", Code: MAD, Fraction: 2, NumericCode: "504", Grapheme: ".\u062f.\u0645", Template: "1 $"},
-	MDL: {Decimal: ".", Thousand: ",", Code: MDL, Fraction: 2, NumericCode: "498", Grapheme: "lei", Template: "1 $"},
-	MGA: {Decimal: ".", Thousand: ",", Code: MGA, Fraction: 2, NumericCode: "969", Grapheme: "Ar", Template: "1$"},
-	MKD: {Decimal: ".", Thousand: ",", Code: MKD, Fraction: 2, NumericCode: "807", Grapheme: "\u0434\u0435\u043d", Template: "$1"},
-	MMK: {Decimal: ".", Thousand: ",", Code: MMK, Fraction: 2, NumericCode: "104", Grapheme: "K", Template: "$1"},
-	MNT: {Decimal: ".", Thousand: ",", Code: MNT, Fraction: 2, NumericCode: "496", Grapheme: "\u20ae", Template: "$1"},
-	MOP: {Decimal: ".", Thousand: ",", Code: MOP, Fraction: 2, NumericCode: "446", Grapheme: "P", Template: "1 $"},
-	MRU: {Decimal: ".", Thousand: ",", Code: MRU, Fraction: 2, NumericCode: "929", Grapheme: "UM", Template: "$1"},
-	MUR: {Decimal: ".", Thousand: ",", Code: MUR, Fraction: 2, NumericCode: "480", Grapheme: "\u20a8", Template: "$1"},
-	MVR: {Decimal: ".", Thousand: ",", Code: MVR, Fraction: 2, NumericCode: "462", Grapheme: "MVR", Template: "1 $"},
-	MWK: {Decimal: ".", Thousand: ",", Code: MWK, Fraction: 2, NumericCode: "454", Grapheme: "MK", Template: "$1"},
-	MXN: {Decimal: ".", Thousand: ",", Code: MXN, Fraction: 2, NumericCode: "484", Grapheme: "$", Template: "$1"},
-	MYR: {Decimal: ".", Thousand: ",", Code: MYR, Fraction: 2, NumericCode: "458", Grapheme: "RM", Template: "$1"},
-	MZN: {Decimal: ".", Thousand: ",", Code: MZN, Fraction: 2, NumericCode: "943", Grapheme: "MT", Template: "$1"},
-	NAD: {Decimal: ".", Thousand: ",", Code: NAD, Fraction: 2, NumericCode: "516", Grapheme: "$", Template: "$1"},
-	NGN: {Decimal: ".", Thousand: ",", Code: NGN, Fraction: 2, NumericCode: "566", Grapheme: "\u20a6", Template: "$1"},
-	NIO: {Decimal: ".", Thousand: ",", Code: NIO, Fraction: 2, NumericCode: "558", Grapheme: "C$", Template: "$1"},
-	NOK: {Decimal: ".", Thousand: ",", Code: NOK, Fraction: 2, NumericCode: "578", Grapheme: "kr", Template: "1 $"},
-	NPR: {Decimal: ".", Thousand: ",", Code: NPR, Fraction: 2, NumericCode: "524", Grapheme: "\u20a8", Template: "$1"},
-	NZD: {Decimal: ".", Thousand: ",", Code: NZD, Fraction: 2, NumericCode: "554", Grapheme: "$", Template: "$1"},
-	OMR: {Decimal: ".", Thousand: ",", Code: OMR, Fraction: 3, NumericCode: "512", Grapheme: "\ufdfc", Template: "1 $"},
-	PAB: {Decimal: ".", Thousand: ",", Code: PAB, Fraction: 2, NumericCode: "590", Grapheme: "B/.", Template: "$1"},
-	PEN: {Decimal: ".", Thousand: ",", Code: PEN, Fraction: 2, NumericCode: "604", Grapheme: "S/", Template: "$1"},
-	PGK: {Decimal: ".", Thousand: ",", Code: PGK, Fraction: 2, NumericCode: "598", Grapheme: "K", Template: "1 $"},
-	PHP: {Decimal: ".", Thousand: ",", Code: PHP, Fraction: 2, NumericCode: "608", Grapheme: "\u20b1", Template: "$1"},
-	PKR: {Decimal: ".", Thousand: ",", Code: PKR, Fraction: 2, NumericCode: "586", Grapheme: "\u20a8", Template: "$1"},
-	PLN: {Decimal: ".", Thousand: ",", Code: PLN, Fraction: 2, NumericCode: "985", Grapheme: "z\u0142", Template: "1 $"},
-	PYG: {Decimal: ".", Thousand: ",", Code: PYG, Fraction: 0, NumericCode: "600", Grapheme: "Gs", Template: "1$"},
-	QAR: {Decimal: ".", Thousand: ",", Code: QAR, Fraction: 2, NumericCode: "634", Grapheme: "\ufdfc", Template: "1 $"},
-	RON: {Decimal: ".", Thousand: ",", Code: RON, Fraction: 2, NumericCode: "946", Grapheme: "lei", Template: "$1"},
-	RSD: {Decimal: ".", Thousand: ",", Code: RSD, Fraction: 2, NumericCode: "941", Grapheme: "\u0414\u0438\u043d.", Template: "$1"},
-	RUB: {Decimal: ".", Thousand: ",", Code: RUB, Fraction: 2, NumericCode: "643", Grapheme: "\u20bd", Template: "1 $"},
-	RUR: {Decimal: ".", Thousand: ",", Code: RUR, Fraction: 2, NumericCode: "", Grapheme: "\u20bd", Template: "1 $"},
-	RWF: {Decimal: ".", Thousand: ",", Code: RWF, Fraction: 0, NumericCode: "646", Grapheme: "FRw", Template: "1 $"},
-	SAR: {Decimal: ".", Thousand: ",", Code: SAR, Fraction: 2, NumericCode: "682", Grapheme: "\ufdfc", Template: "1 $"},
-	SBD: {Decimal: ".", Thousand: ",", Code: SBD, Fraction: 2, NumericCode: "090", Grapheme: "$", Template: "$1"},
-	SCR: {Decimal: ".", Thousand: ",", Code: SCR, Fraction: 2, NumericCode: "690", Grapheme: "\u20a8", Template: "$1"},
-	SDG: {Decimal: ".", Thousand: ",", Code: SDG, Fraction: 2, NumericCode: "938", Grapheme: "\u00a3", Template: "$1"},
-	SEK: {Decimal: ".", Thousand: ",", Code: SEK, Fraction: 2, NumericCode: "752", Grapheme: "kr", Template: "1 $"},
-	SGD: {Decimal: ".", Thousand: ",", Code: SGD, Fraction: 2, NumericCode: "702", Grapheme: "$", Template: "$1"},
-	SHP: {Decimal: ".", Thousand: ",", Code: SHP, Fraction: 2, NumericCode: "654", Grapheme: "\u00a3", Template: "$1"},
-	SKK: {Decimal: ".", Thousand: ",", Code: SKK, Fraction: 2, NumericCode: "", Grapheme: "Sk", Template: "$1"},
-	SLE: {Decimal: ".", Thousand: ",", Code: SLE, Fraction: 2, NumericCode: "925", Grapheme: "Le", Template: "1 $"},
-	SLL: {Decimal: ".", Thousand: ",", Code: SLL, Fraction: 2, NumericCode: "694", Grapheme: "Le", Template: "1 $"},
-	SOS: {Decimal: ".", Thousand: ",", Code: SOS, Fraction: 2, NumericCode: "706", Grapheme: "Sh", Template: "1 $"},
-	SRD: {Decimal: ".", Thousand: ",", Code: SRD, Fraction: 2, NumericCode: "968", Grapheme: "$", Template: "$1"},
-	SSP: {Decimal: ".", Thousand: ",", Code: SSP, Fraction: 2, NumericCode: "728", Grapheme: "\u00a3", Template: "1 $"},
-	STD: {Decimal: ".", Thousand: ",", Code: STD, Fraction: 2, NumericCode: "", Grapheme: "Db", Template: "1 $"},
-	STN: {Decimal: ".", Thousand: ",", Code: STN, Fraction: 2, NumericCode: "930", Grapheme: "Db", Template: "1 $"},
-	SVC: {Decimal: ".", Thousand: ",", Code: SVC, Fraction: 2, NumericCode: "222", Grapheme: "\u20a1", Template: "$1"},
-	SYP: {Decimal: ".", Thousand: ",", Code: SYP, Fraction: 2, NumericCode: "760", Grapheme: "\u00a3", Template: "1 $"},
-	SZL: {Decimal: ".", Thousand: ",", Code: SZL, Fraction: 2, NumericCode: "748", Grapheme: "\u00a3", Template: "$1"},
-	THB: {Decimal: ".", Thousand: ",", Code: THB, Fraction: 2, NumericCode: "764", Grapheme: "\u0e3f", Template: "$1"},
-	TJS: {Decimal: ".", Thousand: ",", Code: TJS, Fraction: 2, NumericCode: "972", Grapheme: "SM", Template: "1 $"},
-	TMT: {Decimal: ".", Thousand: ",", Code: TMT, Fraction: 2, NumericCode: "934", Grapheme: "T", Template: "1 $"},
-	TND: {Decimal: ".", Thousand: ",", Code: TND, Fraction: 3, NumericCode: "788", Grapheme: ".\u062f.\u062a", Template: "1 $"},
-	TOP: {Decimal: ".", Thousand: ",", Code: TOP, Fraction: 2, NumericCode: "776", Grapheme: "T$", Template: "$1"},
-	TRL: {Decimal: ".", Thousand: ",", Code: TRL, Fraction: 2, NumericCode: "", Grapheme: "\u20a4", Template: "$1"},
-	TRY: {Decimal: ".", Thousand: ",", Code: TRY, Fraction: 2, NumericCode: "949", Grapheme: "\u20ba", Template: "$1"},
-	TTD: {Decimal: ".", Thousand: ",", Code: TTD, Fraction: 2, NumericCode: "780", Grapheme: "TT$", Template: "$1"},
-	TWD: {Decimal: ".", Thousand: ",", Code: TWD, Fraction: 2, NumericCode: "901", Grapheme: "NT$", Template: "$1"},
-	TZS: {Decimal: ".", Thousand: ",", Code: TZS, Fraction: 2, NumericCode: "834", Grapheme: "TSh", Template: "$1"},
-	UAH: {Decimal: ".", Thousand: ",", Code: UAH, Fraction: 2, NumericCode: "980", Grapheme: "\u20b4", Template: "1 $"},
-	UGX: {Decimal: ".", Thousand: ",", Code: UGX, Fraction: 0, NumericCode: "800", Grapheme: "USh", Template: "1 $"},
-	USD: {Decimal: ".", Thousand: ",", Code: USD, Fraction: 2, NumericCode: "840", Grapheme: "$", Template: "$1"},
-	UYU: {Decimal: ".", Thousand: ",", Code: UYU, Fraction: 2, NumericCode: "858", Grapheme: "$U", Template: "$1"},
-	UZS: {Decimal: ".", Thousand: ",", Code: UZS, Fraction: 2, NumericCode: "860", Grapheme: "so\u2019m", Template: "$1"},
-	VEF: {Decimal: ".", Thousand: ",", Code: VEF, Fraction: 2, NumericCode: "937", Grapheme: "Bs", Template: "$1"},
-	VES: {Decimal: ".", Thousand: ",", Code: VES, Fraction: 2, NumericCode: "928", Grapheme: "Bs.S", Template: "$1"},
-	VND: {Decimal: ".", Thousand: ",", Code: VND, Fraction: 0, NumericCode: "704", Grapheme: "\u20ab", Template: "1 $"},
-	VUV: {Decimal: ".", Thousand: ",", Code: VUV, Fraction: 0, NumericCode: "548", Grapheme: "Vt", Template: "$1"},
-	WST: {Decimal: ".", Thousand: ",", Code: WST, Fraction: 2, NumericCode: "882", Grapheme: "T", Template: "1 $"},
-	XAF: {Decimal: ".", Thousand: ",", Code: XAF, Fraction: 0, NumericCode: "950", Grapheme: "Fr", Template: "1 $"},
-	XAG: {Decimal: ".", Thousand: ",", Code: XAG, Fraction: 0, NumericCode: "961", Grapheme: "oz t", Template: "1 $"},
-	XAU: {Decimal: ".", Thousand: ",", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "oz t", Template: "1 $"},
-	XCD: {Decimal: ".", Thousand: ",", Code: XCD, Fraction: 2, NumericCode: "951", Grapheme: "$", Template: "$1"},
-	XDR: {Decimal: ".", Thousand: ",", Code: XDR, Fraction: 0, NumericCode: "960", Grapheme: "SDR", Template: "1 $"},
-	XOF: {Decimal: ".", Thousand: ",", Code: XOF, Fraction: 0, NumericCode: "952", Grapheme: "CFA", Template: "1 $"},
-	XPF: {Decimal: ".", Thousand: ",", Code: XPF, Fraction: 0, NumericCode: "953", Grapheme: "₣", Template: "1 $"},
-	YER: {Decimal: ".", Thousand: ",", Code: YER, Fraction: 2, NumericCode: "886", Grapheme: "\ufdfc", Template: "1 $"},
-	ZAR: {Decimal: ".", Thousand: ",", Code: ZAR, Fraction: 2, NumericCode: "710", Grapheme: "R", Template: "$1"},
-	ZMW: {Decimal: ".", Thousand: ",", Code: ZMW, Fraction: 2, NumericCode: "967", Grapheme: "ZK", Template: "$1"},
-	ZWD: {Decimal: ".", Thousand: ",", Code: ZWD, Fraction: 2, NumericCode: "716", Grapheme: "Z$", Template: "$1"},
-	ZWL: {Decimal: ".", Thousand: ",", Code: ZWL, Fraction: 2, NumericCode: "932", Grapheme: "Z$", Template: "$1"},
-}
-
-// AddCurrency lets you insert or update currency in currencies list.
+	AED: {Decimal: ".", Thousand: ",", Code: AED, Fraction: 2, NumericCode: "784", Grapheme: ".\u062f.\u0625", Template: "1 $", Name: "United Arab Emirates Dirham", PluralName: "UAE dirhams", meta: &currencyMeta{countries: []string{"AE"}}, Symbol: "AED", NarrowSymbol: ".\u062f.\u0625", AccountingFraction: 2, CashFraction: 2},
+	AFN: {Decimal: ".", Thousand: ",", Code: AFN, Fraction: 2, NumericCode: "971", Grapheme: "\u060b", Template: "1 $", Name: "Afghan Afghani", PluralName: "Afghan Afghanis", meta: &currencyMeta{countries: []string{"AF"}}, Symbol: "AFN", NarrowSymbol: "\u060b", AccountingFraction: 0, CashFraction: 0},
+	ALL: {Decimal: ".", Thousand: ",", Code: ALL, Fraction: 2, NumericCode: "008", Grapheme: "L", Template: "$1", Name: "Albanian Lek", PluralName: "Albanian lekë", meta: &currencyMeta{countries: []string{"AL"}}, Symbol: "ALL", NarrowSymbol: "L", AccountingFraction: 0, CashFraction: 0},
+	AMD: {Decimal: ".", Thousand: ",", Code: AMD, Fraction: 2, NumericCode: "051", Grapheme: "\u0564\u0580.", Template: "1 $", Name: "Armenian Dram", PluralName: "Armenian drams", meta: &currencyMeta{countries: []string{"AM"}}, Symbol: "AMD", NarrowSymbol: "\u0564\u0580.", AccountingFraction: 2, CashFraction: 0},
+	ANG: {Decimal: ",", Thousand: ".", Code: ANG, Fraction: 2, NumericCode: "532", Grapheme: "\u0192", Template: "$1", Name: "Netherlands Antillean Guilder", PluralName: "Netherlands Antillean guilders", meta: &currencyMeta{countries: []string{"CW", "SX"}}, Symbol: "ANG", NarrowSymbol: "\u0192", AccountingFraction: 2, CashFraction: 2},
+	AOA: {Decimal: ".", Thousand: ",", Code: AOA, Fraction: 2, NumericCode: "973", Grapheme: "Kz", Template: "1$", Name: "Angolan Kwanza", PluralName: "Angolan kwanzas", meta: &currencyMeta{countries: []string{"AO"}}, Symbol: "AOA", NarrowSymbol: "Kz", AccountingFraction: 2, CashFraction: 2},
+	ARS: {Decimal: ",", Thousand: ".", Code: ARS, Fraction: 2, NumericCode: "032", Grapheme: "$", Template: "$1", Name: "Argentine Peso", PluralName: "Argentine pesos", meta: &currencyMeta{countries: []string{"AR"}}, Symbol: "ARS", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	AUD: {Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "$", Template: "$1", Name: "Australian Dollar", PluralName: "Australian dollars", meta: &currencyMeta{countries: []string{"AU", "CC", "CX", "HM", "KI", "NF", "NR", "TV"}}, Symbol: "A$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2, CashIncrement: 5},
+	AWG: {Decimal: ".", Thousand: ",", Code: AWG, Fraction: 2, NumericCode: "533", Grapheme: "\u0192", Template: "1$", Name: "Aruban Florin", PluralName: "Aruban florin", meta: &currencyMeta{countries: []string{"AW"}}, Symbol: "AWG", NarrowSymbol: "\u0192", AccountingFraction: 2, CashFraction: 2},
+	AZN: {Decimal: ".", Thousand: ",", Code: AZN, Fraction: 2, NumericCode: "944", Grapheme: "\u20bc", Template: "$1", Name: "Azerbaijani Manat", PluralName: "Azerbaijani manats", meta: &currencyMeta{countries: []string{"AZ"}}, Symbol: "AZN", NarrowSymbol: "\u20bc", AccountingFraction: 2, CashFraction: 2},
+	BAM: {Decimal: ".", Thousand: ",", Code: BAM, Fraction: 2, NumericCode: "977", Grapheme: "KM", Template: "$1", Name: "Bosnia-Herzegovina Convertible Mark", PluralName: "Bosnia-Herzegovina convertible marks", meta: &currencyMeta{countries: []string{"BA"}}, Symbol: "BAM", NarrowSymbol: "KM", AccountingFraction: 2, CashFraction: 2},
+	BBD: {Decimal: ".", Thousand: ",", Code: BBD, Fraction: 2, NumericCode: "052", Grapheme: "$", Template: "$1", Name: "Barbadian Dollar", PluralName: "Barbadian dollars", meta: &currencyMeta{countries: []string{"BB"}}, Symbol: "BBD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	BDT: {Decimal: ".", Thousand: ",", Code: BDT, Fraction: 2, NumericCode: "050", Grapheme: "\u09f3", Template: "$1", Name: "Bangladeshi Taka", PluralName: "Bangladeshi takas", meta: &currencyMeta{countries: []string{"BD"}}, Symbol: "BDT", NarrowSymbol: "\u09f3", AccountingFraction: 2, CashFraction: 2},
+	BGN: {Decimal: ".", Thousand: ",", Code: BGN, Fraction: 2, NumericCode: "975", Grapheme: "\u043b\u0432", Template: "$1", Name: "Bulgarian Lev", PluralName: "Bulgarian leva", meta: &currencyMeta{countries: []string{"BG"}}, Symbol: "BGN", NarrowSymbol: "\u043b\u0432", AccountingFraction: 2, CashFraction: 2},
+	BHD: {Decimal: ".", Thousand: ",", Code: BHD, Fraction: 3, NumericCode: "048", Grapheme: ".\u062f.\u0628", Template: "1 $", Name: "Bahraini Dinar", PluralName: "Bahraini dinars", meta: &currencyMeta{countries: []string{"BH"}}, Symbol: "BHD", NarrowSymbol: ".\u062f.\u0628", AccountingFraction: 3, CashFraction: 3},
+	BIF: {Decimal: ".", Thousand: ",", Code: BIF, Fraction: 0, NumericCode: "108", Grapheme: "Fr", Template: "1$", Name: "Burundian Franc", PluralName: "Burundian francs", meta: &currencyMeta{countries: []string{"BI"}}, Symbol: "BIF", NarrowSymbol: "Fr", AccountingFraction: 0, CashFraction: 0},
+	BMD: {Decimal: ".", Thousand: ",", Code: BMD, Fraction: 2, NumericCode: "060", Grapheme: "$", Template: "$1", Name: "Bermudan Dollar", PluralName: "Bermudan dollars", meta: &currencyMeta{countries: []string{"BM"}}, Symbol: "BMD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	BND: {Decimal: ".", Thousand: ",", Code: BND, Fraction: 2, NumericCode: "096", Grapheme: "$", Template: "$1", Name: "Brunei Dollar", PluralName: "Brunei dollars", meta: &currencyMeta{countries: []string{"BN"}}, Symbol: "BND", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	BOB: {Decimal: ".", Thousand: ",", Code: BOB, Fraction: 2, NumericCode: "068", Grapheme: "Bs.", Template: "$1", Name: "Bolivian Boliviano", PluralName: "Bolivian bolivianos", meta: &currencyMeta{countries: []string{"BO"}}, Symbol: "BOB", NarrowSymbol: "Bs.", AccountingFraction: 2, CashFraction: 2},
+	BRL: {Decimal: ",", Thousand: ".", Code: BRL, Fraction: 2, NumericCode: "986", Grapheme: "R$", Template: "$1", Name: "Brazilian Real", PluralName: "Brazilian reals", meta: &currencyMeta{countries: []string{"BR"}}, Symbol: "R$", NarrowSymbol: "R$", AccountingFraction: 2, CashFraction: 2},
+	BSD: {Decimal: ".", Thousand: ",", Code: BSD, Fraction: 2, NumericCode: "044", Grapheme: "$", Template: "$1", Name: "Bahamian Dollar", PluralName: "Bahamian dollars", meta: &currencyMeta{countries: []string{"BS"}}, Symbol: "BSD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	BTN: {Decimal: ".", Thousand: ",", Code: BTN, Fraction: 2, NumericCode: "064", Grapheme: "Nu.", Template: "1$", Name: "Bhutanese Ngultrum", PluralName: "Bhutanese ngultrums", meta: &currencyMeta{countries: []string{"BT"}}, Symbol: "BTN", NarrowSymbol: "Nu.", AccountingFraction: 2, CashFraction: 2},
+	BWP: {Decimal: ".", Thousand: ",", Code: BWP, Fraction: 2, NumericCode: "072", Grapheme: "P", Template: "$1", Name: "Botswanan Pula", PluralName: "Botswanan pulas", meta: &currencyMeta{countries: []string{"BW"}}, Symbol: "BWP", NarrowSymbol: "P", AccountingFraction: 2, CashFraction: 2},
+	BYN: {Decimal: ",", Thousand: " ", Code: BYN, Fraction: 2, NumericCode: "933", Grapheme: "p.", Template: "1 $", Name: "Belarusian Ruble", PluralName: "Belarusian rubles", meta: &currencyMeta{countries: []string{"BY"}}, Symbol: "BYN", NarrowSymbol: "p.", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2016, 7, 1)},
+	BYR: {Decimal: ",", Thousand: " ", Code: BYR, Fraction: 0, NumericCode: "", Grapheme: "p.", Template: "1 $", Name: "Belarusian Ruble (2000–2016)", PluralName: "Belarusian rubles (2000–2016)", meta: &currencyMeta{countries: []string{"BY"}}, Symbol: "BYR", NarrowSymbol: "p.", AccountingFraction: 0, CashFraction: 0, Status: CurrencyWithdrawn, ValidTo: day(2017, 1, 1)},
+	BZD: {Decimal: ".", Thousand: ",", Code: BZD, Fraction: 2, NumericCode: "084", Grapheme: "BZ$", Template: "$1", Name: "Belize Dollar", PluralName: "Belize dollars", meta: &currencyMeta{countries: []string{"BZ"}}, Symbol: "BZD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	CAD: {Decimal: ".", Thousand: ",", Code: CAD, Fraction: 2, NumericCode: "124", Grapheme: "$", Template: "$1", Name: "Canadian Dollar", PluralName: "Canadian dollars", meta: &currencyMeta{countries: []string{"CA"}}, Symbol: "CA$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2, CashIncrement: 5},
+	CDF: {Decimal: ".", Thousand: ",", Code: CDF, Fraction: 2, NumericCode: "976", Grapheme: "FC", Template: "1$", Name: "Congolese Franc", PluralName: "Congolese francs", meta: &currencyMeta{countries: []string{"CD"}}, Symbol: "CDF", NarrowSymbol: "FC", AccountingFraction: 2, CashFraction: 2},
+	CHF: {Decimal: ".", Thousand: ",", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Template: "1 $", Name: "Swiss Franc", PluralName: "Swiss francs", meta: &currencyMeta{countries: []string{"CH", "LI"}}, Symbol: "CHF", NarrowSymbol: "CHF", AccountingFraction: 2, CashFraction: 2, CashIncrement: 5},
+	CLF: {Decimal: ",", Thousand: ".", Code: CLF, Fraction: 4, NumericCode: "990", Grapheme: "UF", Template: "$1", Name: "Chilean Unit of Account (UF)", PluralName: "Chilean units of account (UF)", meta: &currencyMeta{countries: []string{"CL"}}, Symbol: "CLF", NarrowSymbol: "UF", AccountingFraction: 4, CashFraction: 4},
+	CLP: {Decimal: ",", Thousand: ".", Code: CLP, Fraction: 0, NumericCode: "152", Grapheme: "$", Template: "$1", Name: "Chilean Peso", PluralName: "Chilean pesos", meta: &currencyMeta{countries: []string{"CL"}}, Symbol: "CLP", NarrowSymbol: "$", AccountingFraction: 0, CashFraction: 0},
+	CNY: {Decimal: ".", Thousand: ",", Code: CNY, Fraction: 2, NumericCode: "156", Grapheme: "\u5143", Template: "1 $", Name: "Chinese Yuan", PluralName: "Chinese yuan", meta: &currencyMeta{countries: []string{"CN"}}, Symbol: "CN¥", NarrowSymbol: "¥", AccountingFraction: 2, CashFraction: 2},
+	COP: {Decimal: ",", Thousand: ".", Code: COP, Fraction: 2, NumericCode: "170", Grapheme: "$", Template: "$1", Name: "Colombian Peso", PluralName: "Colombian pesos", meta: &currencyMeta{countries: []string{"CO"}}, Symbol: "COP", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 0},
+	CRC: {Decimal: ".", Thousand: ",", Code: CRC, Fraction: 2, NumericCode: "188", Grapheme: "\u20a1", Template: "$1", Name: "Costa Rican Colón", PluralName: "Costa Rican colóns", meta: &currencyMeta{countries: []string{"CR"}}, Symbol: "CRC", NarrowSymbol: "\u20a1", AccountingFraction: 2, CashFraction: 0},
+	CUC: {Decimal: ".", Thousand: ",", Code: CUC, Fraction: 2, NumericCode: "931", Grapheme: "$", Template: "1$", Name: "Cuban Convertible Peso", PluralName: "Cuban convertible pesos", meta: &currencyMeta{countries: []string{"CU"}}, Symbol: "CUC", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	CUP: {Decimal: ".", Thousand: ",", Code: CUP, Fraction: 2, NumericCode: "192", Grapheme: "$MN", Template: "$1", Name: "Cuban Peso", PluralName: "Cuban pesos", meta: &currencyMeta{countries: []string{"CU"}}, Symbol: "CUP", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	CVE: {Decimal: ".", Thousand: ",", Code: CVE, Fraction: 2, NumericCode: "132", Grapheme: "$", Template: "1$", Name: "Cape Verdean Escudo", PluralName: "Cape Verdean escudos", meta: &currencyMeta{countries: []string{"CV"}}, Symbol: "CVE", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	CZK: {Decimal: ".", Thousand: ",", Code: CZK, Fraction: 2, NumericCode: "203", Grapheme: "K\u010d", Template: "1 $", Name: "Czech Koruna", PluralName: "Czech korunas", meta: &currencyMeta{countries: []string{"CZ"}}, Symbol: "CZK", NarrowSymbol: "K\u010d", AccountingFraction: 2, CashFraction: 0, CashIncrement: 100},
+	DJF: {Decimal: ".", Thousand: ",", Code: DJF, Fraction: 0, NumericCode: "262", Grapheme: "Fdj", Template: "1 $", Name: "Djiboutian Franc", PluralName: "Djiboutian francs", meta: &currencyMeta{countries: []string{"DJ"}}, Symbol: "DJF", NarrowSymbol: "Fdj", AccountingFraction: 0, CashFraction: 0},
+	DKK: {Decimal: ",", Thousand: ".", Code: DKK, Fraction: 2, NumericCode: "208", Grapheme: "kr", Template: "$ 1", Name: "Danish Krone", PluralName: "Danish kroner", meta: &currencyMeta{countries: []string{"DK", "FO", "GL"}}, Symbol: "DKK", NarrowSymbol: "kr", AccountingFraction: 2, CashFraction: 2, CashIncrement: 50},
+	DOP: {Decimal: ".", Thousand: ",", Code: DOP, Fraction: 2, NumericCode: "214", Grapheme: "RD$", Template: "$1", Name: "Dominican Peso", PluralName: "Dominican pesos", meta: &currencyMeta{countries: []string{"DO"}}, Symbol: "DOP", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	DZD: {Decimal: ".", Thousand: ",", Code: DZD, Fraction: 2, NumericCode: "012", Grapheme: ".\u062f.\u062c", Template: "1 $", Name: "Algerian Dinar", PluralName: "Algerian dinars", meta: &currencyMeta{countries: []string{"DZ"}}, Symbol: "DZD", NarrowSymbol: ".\u062f.\u062c", AccountingFraction: 2, CashFraction: 2},
+	EEK: {Decimal: ".", Thousand: ",", Code: EEK, Fraction: 2, NumericCode: "", Grapheme: "kr", Template: "$1", Name: "Estonian Kroon", PluralName: "Estonian kroons", meta: &currencyMeta{countries: []string{"EE"}}, Symbol: "EEK", NarrowSymbol: "kr", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2011, 1, 1)},
+	EGP: {Decimal: ".", Thousand: ",", Code: EGP, Fraction: 2, NumericCode: "818", Grapheme: "\u00a3", Template: "$1", Name: "Egyptian Pound", PluralName: "Egyptian pounds", meta: &currencyMeta{countries: []string{"EG"}}, Symbol: "EGP", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	ERN: {Decimal: ".", Thousand: ",", Code: ERN, Fraction: 2, NumericCode: "232", Grapheme: "Nfk", Template: "1 $", Name: "Eritrean Nakfa", PluralName: "Eritrean nakfas", meta: &currencyMeta{countries: []string{"ER"}}, Symbol: "ERN", NarrowSymbol: "Nfk", AccountingFraction: 2, CashFraction: 2},
+	ETB: {Decimal: ".", Thousand: ",", Code: ETB, Fraction: 2, NumericCode: "230", Grapheme: "Br", Template: "1 $", Name: "Ethiopian Birr", PluralName: "Ethiopian birrs", meta: &currencyMeta{countries: []string{"ET"}}, Symbol: "ETB", NarrowSymbol: "Br", AccountingFraction: 2, CashFraction: 2},
+	EUR: {Decimal: ".", Thousand: ",", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "\u20ac", Template: "$1", Name: "Euro", PluralName: "euros", meta: &currencyMeta{countries: []string{"AD", "AT", "AX", "BE", "BL", "CY", "DE", "EE", "ES", "FI", "FR", "GF", "GP", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MC", "ME", "MF", "MQ", "MT", "NL", "PM", "PT", "RE", "SI", "SK", "SM", "TF", "VA", "YT"}}, Symbol: "€", NarrowSymbol: "€", AccountingFraction: 2, CashFraction: 2},
+	FJD: {Decimal: ".", Thousand: ",", Code: FJD, Fraction: 2, NumericCode: "242", Grapheme: "$", Template: "$1", Name: "Fijian Dollar", PluralName: "Fijian dollars", meta: &currencyMeta{countries: []string{"FJ"}}, Symbol: "FJD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	FKP: {Decimal: ".", Thousand: ",", Code: FKP, Fraction: 2, NumericCode: "238", Grapheme: "\u00a3", Template: "$1", Name: "Falkland Islands Pound", PluralName: "Falkland Islands pounds", meta: &currencyMeta{countries: []string{"FK"}}, Symbol: "FKP", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	GBP: {Decimal: ".", Thousand: ",", Code: GBP, Fraction: 2, NumericCode: "826", Grapheme: "\u00a3", Template: "$1", Name: "British Pound", PluralName: "British pounds", meta: &currencyMeta{countries: []string{"GB", "GG", "IM", "JE"}}, Symbol: "£", NarrowSymbol: "£", AccountingFraction: 2, CashFraction: 2},
+	GEL: {Decimal: ".", Thousand: ",", Code: GEL, Fraction: 2, NumericCode: "981", Grapheme: "\u10da", Template: "1 $", Name: "Georgian Lari", PluralName: "Georgian laris", meta: &currencyMeta{countries: []string{"GE"}}, Symbol: "GEL", NarrowSymbol: "\u10da", AccountingFraction: 2, CashFraction: 2},
+	GGP: {Decimal: ".", Thousand: ",", Code: GGP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1", Name: "Guernsey Pound", PluralName: "Guernsey pounds", meta: &currencyMeta{countries: []string{"GG"}}, Symbol: "GGP", NarrowSymbol: "£", AccountingFraction: 2, CashFraction: 2},
+	GHC: {Decimal: ".", Thousand: ",", Code: GHC, Fraction: 2, NumericCode: "", Grapheme: "\u00a2", Template: "$1", Name: "Ghanaian Cedi (1979–2007)", PluralName: "Ghanaian cedis (1979–2007)", meta: &currencyMeta{countries: []string{"GH"}}, Symbol: "GHC", NarrowSymbol: "\u00a2", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2007, 7, 1)},
+	GHS: {Decimal: ".", Thousand: ",", Code: GHS, Fraction: 2, NumericCode: "936", Grapheme: "\u20b5", Template: "$1", Name: "Ghanaian Cedi", PluralName: "Ghanaian cedis", meta: &currencyMeta{countries: []string{"GH"}}, Symbol: "GHS", NarrowSymbol: "\u20b5", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2007, 7, 1)},
+	GIP: {Decimal: ".", Thousand: ",", Code: GIP, Fraction: 2, NumericCode: "292", Grapheme: "\u00a3", Template: "$1", Name: "Gibraltar Pound", PluralName: "Gibraltar pounds", meta: &currencyMeta{countries: []string{"GI"}}, Symbol: "GIP", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	GMD: {Decimal: ".", Thousand: ",", Code: GMD, Fraction: 2, NumericCode: "270", Grapheme: "D", Template: "1 $", Name: "Gambian Dalasi", PluralName: "Gambian dalasis", meta: &currencyMeta{countries: []string{"GM"}}, Symbol: "GMD", NarrowSymbol: "D", AccountingFraction: 2, CashFraction: 2},
+	GNF: {Decimal: ".", Thousand: ",", Code: GNF, Fraction: 0, NumericCode: "324", Grapheme: "FG", Template: "1 $", Name: "Guinean Franc", PluralName: "Guinean francs", meta: &currencyMeta{countries: []string{"GN"}}, Symbol: "GNF", NarrowSymbol: "FG", AccountingFraction: 0, CashFraction: 0},
+	GTQ: {Decimal: ".", Thousand: ",", Code: GTQ, Fraction: 2, NumericCode: "320", Grapheme: "Q", Template: "$1", Name: "Guatemalan Quetzal", PluralName: "Guatemalan quetzals", meta: &currencyMeta{countries: []string{"GT"}}, Symbol: "GTQ", NarrowSymbol: "Q", AccountingFraction: 2, CashFraction: 2},
+	GYD: {Decimal: ".", Thousand: ",", Code: GYD, Fraction: 2, NumericCode: "328", Grapheme: "$", Template: "$1", Name: "Guyanaese Dollar", PluralName: "Guyanaese dollars", meta: &currencyMeta{countries: []string{"GY"}}, Symbol: "GYD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 0},
+	HKD: {Decimal: ".", Thousand: ",", Code: HKD, Fraction: 2, NumericCode: "344", Grapheme: "$", Template: "$1", Name: "Hong Kong Dollar", PluralName: "Hong Kong dollars", meta: &currencyMeta{countries: []string{"HK"}}, Symbol: "HK$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	HNL: {Decimal: ".", Thousand: ",", Code: HNL, Fraction: 2, NumericCode: "340", Grapheme: "L", Template: "$1", Name: "Honduran Lempira", PluralName: "Honduran lempiras", meta: &currencyMeta{countries: []string{"HN"}}, Symbol: "HNL", NarrowSymbol: "L", AccountingFraction: 2, CashFraction: 2},
+	HRK: {Decimal: ",", Thousand: ".", Code: HRK, Fraction: 2, NumericCode: "191", Grapheme: "kn", Template: "1 $", Name: "Croatian Kuna", PluralName: "Croatian kunas", meta: &currencyMeta{countries: []string{"HR"}}, Symbol: "HRK", NarrowSymbol: "kn", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2023, 1, 1)},
+	HTG: {Decimal: ",", Thousand: ".", Code: HTG, Fraction: 2, NumericCode: "332", Grapheme: "G", Template: "1 $", Name: "Haitian Gourde", PluralName: "Haitian gourdes", meta: &currencyMeta{countries: []string{"HT"}}, Symbol: "HTG", NarrowSymbol: "G", AccountingFraction: 2, CashFraction: 2},
+	HUF: {Decimal: ",", Thousand: ".", Code: HUF, Fraction: 2, NumericCode: "348", Grapheme: "Ft", Template: "1 $", Name: "Hungarian Forint", PluralName: "Hungarian forints", meta: &currencyMeta{countries: []string{"HU"}}, Symbol: "HUF", NarrowSymbol: "Ft", AccountingFraction: 2, CashFraction: 0, CashIncrement: 500},
+	IDR: {Decimal: ",", Thousand: ".", Code: IDR, Fraction: 2, NumericCode: "360", Grapheme: "Rp", Template: "$1", Name: "Indonesian Rupiah", PluralName: "Indonesian rupiahs", meta: &currencyMeta{countries: []string{"ID"}}, Symbol: "IDR", NarrowSymbol: "Rp", AccountingFraction: 2, CashFraction: 0},
+	ILS: {Decimal: ".", Thousand: ",", Code: ILS, Fraction: 2, NumericCode: "376", Grapheme: "\u20aa", Template: "$1", Name: "Israeli New Shekel", PluralName: "Israeli new shekels", meta: &currencyMeta{countries: []string{"IL"}}, Symbol: "₪", NarrowSymbol: "₪", AccountingFraction: 2, CashFraction: 2},
+	IMP: {Decimal: ".", Thousand: ",", Code: IMP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1", Name: "Manx Pound", PluralName: "Manx pounds", meta: &currencyMeta{countries: []string{"IM"}}, Symbol: "IMP", NarrowSymbol: "£", AccountingFraction: 2, CashFraction: 2},
+	INR: {Decimal: ".", Thousand: ",", Code: INR, Fraction: 2, NumericCode: "356", Grapheme: "\u20b9", Template: "$1", Name: "Indian Rupee", PluralName: "Indian rupees", meta: &currencyMeta{countries: []string{"BT", "IN"}}, Symbol: "₹", NarrowSymbol: "₹", AccountingFraction: 2, CashFraction: 2},
+	IQD: {Decimal: ".", Thousand: ",", Code: IQD, Fraction: 3, NumericCode: "368", Grapheme: ".\u062f.\u0639", Template: "1 $", Name: "Iraqi Dinar", PluralName: "Iraqi dinars", meta: &currencyMeta{countries: []string{"IQ"}}, Symbol: "IQD", NarrowSymbol: ".\u062f.\u0639", AccountingFraction: 0, CashFraction: 0},
+	IRR: {Decimal: ".", Thousand: ",", Code: IRR, Fraction: 2, NumericCode: "364", Grapheme: "\ufdfc", Template: "1 $", Name: "Iranian Rial", PluralName: "Iranian rials", meta: &currencyMeta{countries: []string{"IR"}}, Symbol: "IRR", NarrowSymbol: "\ufdfc", AccountingFraction: 0, CashFraction: 0},
+	ISK: {Decimal: ",", Thousand: ".", Code: ISK, Fraction: 0, NumericCode: "352", Grapheme: "kr", Template: "$1", Name: "Icelandic Króna", PluralName: "Icelandic krónur", meta: &currencyMeta{countries: []string{"IS"}, exponents: map[string]int{"stripe": 2}}, Symbol: "ISK", NarrowSymbol: "kr", AccountingFraction: 0, CashFraction: 0},
+	JEP: {Decimal: ".", Thousand: ",", Code: JEP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1", Name: "Jersey Pound", PluralName: "Jersey pounds", meta: &currencyMeta{countries: []string{"JE"}}, Symbol: "JEP", NarrowSymbol: "£", AccountingFraction: 2, CashFraction: 2},
+	JMD: {Decimal: ".", Thousand: ",", Code: JMD, Fraction: 2, NumericCode: "388", Grapheme: "J$", Template: "$1", Name: "Jamaican Dollar", PluralName: "Jamaican dollars", meta: &currencyMeta{countries: []string{"JM"}}, Symbol: "JMD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	JOD: {Decimal: ".", Thousand: ",", Code: JOD, Fraction: 3, NumericCode: "400", Grapheme: ".\u062f.\u0625", Template: "1 $", Name: "Jordanian Dinar", PluralName: "Jordanian dinars", meta: &currencyMeta{countries: []string{"JO"}}, Symbol: "JOD", NarrowSymbol: ".\u062f.\u0625", AccountingFraction: 3, CashFraction: 3},
+	JPY: {Decimal: ".", Thousand: ",", Code: JPY, Fraction: 0, NumericCode: "392", Grapheme: "\u00a5", Template: "$1", Name: "Japanese Yen", PluralName: "Japanese yen", meta: &currencyMeta{countries: []string{"JP"}}, Symbol: "¥", NarrowSymbol: "¥", AccountingFraction: 0, CashFraction: 0},
+	KES: {Decimal: ".", Thousand: ",", Code: KES, Fraction: 2, NumericCode: "404", Grapheme: "KSh", Template: "$1", Name: "Kenyan Shilling", PluralName: "Kenyan shillings", meta: &currencyMeta{countries: []string{"KE"}}, Symbol: "KES", NarrowSymbol: "KSh", AccountingFraction: 2, CashFraction: 2},
+	KGS: {Decimal: ".", Thousand: ",", Code: KGS, Fraction: 2, NumericCode: "417", Grapheme: "\u0441\u043e\u043c", Template: "1 $", Name: "Kyrgystani Som", PluralName: "Kyrgystani soms", meta: &currencyMeta{countries: []string{"KG"}}, Symbol: "KGS", NarrowSymbol: "\u0441\u043e\u043c", AccountingFraction: 2, CashFraction: 2},
+	KHR: {Decimal: ".", Thousand: ",", Code: KHR, Fraction: 2, NumericCode: "116", Grapheme: "\u17db", Template: "$1", Name: "Cambodian Riel", PluralName: "Cambodian riels", meta: &currencyMeta{countries: []string{"KH"}}, Symbol: "KHR", NarrowSymbol: "\u17db", AccountingFraction: 2, CashFraction: 2},
+	KMF: {Decimal: ".", Thousand: ",", Code: KMF, Fraction: 0, NumericCode: "174", Grapheme: "CF", Template: "$1", Name: "Comorian Franc", PluralName: "Comorian francs", meta: &currencyMeta{countries: []string{"KM"}}, Symbol: "KMF", NarrowSymbol: "CF", AccountingFraction: 0, CashFraction: 0},
+	KPW: {Decimal: ".", Thousand: ",", Code: KPW, Fraction: 2, NumericCode: "408", Grapheme: "\u20a9", Template: "$1", Name: "North Korean Won", PluralName: "North Korean won", meta: &currencyMeta{countries: []string{"KP"}}, Symbol: "KPW", NarrowSymbol: "\u20a9", AccountingFraction: 0, CashFraction: 0},
+	KRW: {Decimal: ".", Thousand: ",", Code: KRW, Fraction: 0, NumericCode: "410", Grapheme: "\u20a9", Template: "$1", Name: "South Korean Won", PluralName: "South Korean won", meta: &currencyMeta{countries: []string{"KR"}}, Symbol: "₩", NarrowSymbol: "₩", AccountingFraction: 0, CashFraction: 0},
+	KWD: {Decimal: ".", Thousand: ",", Code: KWD, Fraction: 3, NumericCode: "414", Grapheme: ".\u062f.\u0643", Template: "1 $", Name: "Kuwaiti Dinar", PluralName: "Kuwaiti dinars", meta: &currencyMeta{countries: []string{"KW"}}, Symbol: "KWD", NarrowSymbol: ".\u062f.\u0643", AccountingFraction: 3, CashFraction: 3},
+	KYD: {Decimal: ".", Thousand: ",", Code: KYD, Fraction: 2, NumericCode: "136", Grapheme: "$", Template: "$1", Name: "Cayman Islands Dollar", PluralName: "Cayman Islands dollars", meta: &currencyMeta{countries: []string{"KY"}}, Symbol: "KYD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	KZT: {Decimal: ".", Thousand: ",", Code: KZT, Fraction: 2, NumericCode: "398", Grapheme: "\u20b8", Template: "$1", Name: "Kazakhstani Tenge", PluralName: "Kazakhstani tenges", meta: &currencyMeta{countries: []string{"KZ"}}, Symbol: "KZT", NarrowSymbol: "\u20b8", AccountingFraction: 2, CashFraction: 2},
+	LAK: {Decimal: ".", Thousand: ",", Code: LAK, Fraction: 2, NumericCode: "418", Grapheme: "\u20ad", Template: "$1", Name: "Laotian Kip", PluralName: "Laotian kips", meta: &currencyMeta{countries: []string{"LA"}}, Symbol: "LAK", NarrowSymbol: "\u20ad", AccountingFraction: 0, CashFraction: 0},
+	LBP: {Decimal: ".", Thousand: ",", Code: LBP, Fraction: 2, NumericCode: "422", Grapheme: "\u00a3", Template: "$1", Name: "Lebanese Pound", PluralName: "Lebanese pounds", meta: &currencyMeta{countries: []string{"LB"}}, Symbol: "LBP", NarrowSymbol: "\u00a3", AccountingFraction: 0, CashFraction: 0},
+	LKR: {Decimal: ".", Thousand: ",", Code: LKR, Fraction: 2, NumericCode: "144", Grapheme: "\u20a8", Template: "$1", Name: "Sri Lankan Rupee", PluralName: "Sri Lankan rupees", meta: &currencyMeta{countries: []string{"LK"}}, Symbol: "LKR", NarrowSymbol: "\u20a8", AccountingFraction: 2, CashFraction: 2},
+	LRD: {Decimal: ".", Thousand: ",", Code: LRD, Fraction: 2, NumericCode: "430", Grapheme: "$", Template: "$1", Name: "Liberian Dollar", PluralName: "Liberian dollars", meta: &currencyMeta{countries: []string{"LR"}}, Symbol: "LRD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	LSL: {Decimal: ".", Thousand: ",", Code: LSL, Fraction: 2, NumericCode: "426", Grapheme: "L", Template: "$1", Name: "Lesotho Loti", PluralName: "Lesotho lotis", meta: &currencyMeta{countries: []string{"LS"}}, Symbol: "LSL", NarrowSymbol: "L", AccountingFraction: 2, CashFraction: 2},
+	LTL: {Decimal: ".", Thousand: ",", Code: LTL, Fraction: 2, NumericCode: "", Grapheme: "Lt", Template: "$1", Name: "Lithuanian Litas", PluralName: "Lithuanian litai", meta: &currencyMeta{countries: []string{"LT"}}, Symbol: "LTL", NarrowSymbol: "Lt", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2015, 1, 1)},
+	LVL: {Decimal: ".", Thousand: ",", Code: LVL, Fraction: 2, NumericCode: "", Grapheme: "Ls", Template: "1 $", Name: "Latvian Lats", PluralName: "Latvian lati", meta: &currencyMeta{countries: []string{"LV"}}, Symbol: "LVL", NarrowSymbol: "Ls", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2014, 1, 1)},
+	LYD: {Decimal: ".", Thousand: ",", Code: LYD, Fraction: 3, NumericCode: "434", Grapheme: ".\u062f.\u0644", Template: "1 $", Name: "Libyan Dinar", PluralName: "Libyan dinars", meta: &currencyMeta{countries: []string{"LY"}}, Symbol: "LYD", NarrowSymbol: ".\u062f.\u0644", AccountingFraction: 3, CashFraction: 3},
+	MAD: {Decimal: ".", Thousand: ",", Code: MAD, Fraction: 2, NumericCode: "504", Grapheme: ".\u062f.\u0645", Template: "1 $", Name: "Moroccan Dirham", PluralName: "Moroccan dirhams", meta: &currencyMeta{countries: []string{"EH", "MA"}}, Symbol: "MAD", NarrowSymbol: ".\u062f.\u0645", AccountingFraction: 2, CashFraction: 2},
+	MDL: {Decimal: ".", Thousand: ",", Code: MDL, Fraction: 2, NumericCode: "498", Grapheme: "lei", Template: "1 $", Name: "Moldovan Leu", PluralName: "Moldovan lei", meta: &currencyMeta{countries: []string{"MD"}}, Symbol: "MDL", NarrowSymbol: "lei", AccountingFraction: 2, CashFraction: 2},
+	MGA: {Decimal: ".", Thousand: ",", Code: MGA, Fraction: 2, NumericCode: "969", Grapheme: "Ar", Template: "1$", Name: "Malagasy Ariary", PluralName: "Malagasy ariaries", meta: &currencyMeta{countries: []string{"MG"}}, Symbol: "MGA", NarrowSymbol: "Ar", AccountingFraction: 0, CashFraction: 0},
+	MKD: {Decimal: ".", Thousand: ",", Code: MKD, Fraction: 2, NumericCode: "807", Grapheme: "\u0434\u0435\u043d", Template: "$1", Name: "Macedonian Denar", PluralName: "Macedonian denari", meta: &currencyMeta{countries: []string{"MK"}}, Symbol: "MKD", NarrowSymbol: "\u0434\u0435\u043d", AccountingFraction: 2, CashFraction: 2},
+	MMK: {Decimal: ".", Thousand: ",", Code: MMK, Fraction: 2, NumericCode: "104", Grapheme: "K", Template: "$1", Name: "Myanmar Kyat", PluralName: "Myanmar kyats", meta: &currencyMeta{countries: []string{"MM"}}, Symbol: "MMK", NarrowSymbol: "K", AccountingFraction: 0, CashFraction: 0},
+	MNT: {Decimal: ".", Thousand: ",", Code: MNT, Fraction: 2, NumericCode: "496", Grapheme: "\u20ae", Template: "$1", Name: "Mongolian Tugrik", PluralName: "Mongolian tugriks", meta: &currencyMeta{countries: []string{"MN"}}, Symbol: "MNT", NarrowSymbol: "\u20ae", AccountingFraction: 2, CashFraction: 0},
+	MOP: {Decimal: ".", Thousand: ",", Code: MOP, Fraction: 2, NumericCode: "446", Grapheme: "P", Template: "1 $", Name: "Macanese Pataca", PluralName: "Macanese patacas", meta: &currencyMeta{countries: []string{"MO"}}, Symbol: "MOP", NarrowSymbol: "P", AccountingFraction: 2, CashFraction: 2},
+	MRU: {Decimal: ".", Thousand: ",", Code: MRU, Fraction: 2, NumericCode: "929", Grapheme: "UM", Template: "$1", Name: "Mauritanian Ouguiya", PluralName: "Mauritanian ouguiyas", meta: &currencyMeta{countries: []string{"MR"}}, Symbol: "MRU", NarrowSymbol: "UM", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2018, 1, 1)},
+	MUR: {Decimal: ".", Thousand: ",", Code: MUR, Fraction: 2, NumericCode: "480", Grapheme: "\u20a8", Template: "$1", Name: "Mauritian Rupee", PluralName: "Mauritian rupees", meta: &currencyMeta{countries: []string{"MU"}}, Symbol: "MUR", NarrowSymbol: "\u20a8", AccountingFraction: 2, CashFraction: 0},
+	MVR: {Decimal: ".", Thousand: ",", Code: MVR, Fraction: 2, NumericCode: "462", Grapheme: "MVR", Template: "1 $", Name: "Maldivian Rufiyaa", PluralName: "Maldivian rufiyaas", meta: &currencyMeta{countries: []string{"MV"}}, Symbol: "MVR", NarrowSymbol: "MVR", AccountingFraction: 2, CashFraction: 2},
+	MWK: {Decimal: ".", Thousand: ",", Code: MWK, Fraction: 2, NumericCode: "454", Grapheme: "MK", Template: "$1", Name: "Malawian Kwacha", PluralName: "Malawian kwachas", meta: &currencyMeta{countries: []string{"MW"}}, Symbol: "MWK", NarrowSymbol: "MK", AccountingFraction: 2, CashFraction: 2},
+	MXN: {Decimal: ".", Thousand: ",", Code: MXN, Fraction: 2, NumericCode: "484", Grapheme: "$", Template: "$1", Name: "Mexican Peso", PluralName: "Mexican pesos", meta: &currencyMeta{countries: []string{"MX"}}, Symbol: "MX$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	MYR: {Decimal: ".", Thousand: ",", Code: MYR, Fraction: 2, NumericCode: "458", Grapheme: "RM", Template: "$1", Name: "Malaysian Ringgit", PluralName: "Malaysian ringgits", meta: &currencyMeta{countries: []string{"MY"}}, Symbol: "MYR", NarrowSymbol: "RM", AccountingFraction: 2, CashFraction: 2},
+	MZN: {Decimal: ".", Thousand: ",", Code: MZN, Fraction: 2, NumericCode: "943", Grapheme: "MT", Template: "$1", Name: "Mozambican Metical", PluralName: "Mozambican meticals", meta: &currencyMeta{countries: []string{"MZ"}}, Symbol: "MZN", NarrowSymbol: "MT", AccountingFraction: 2, CashFraction: 2},
+	NAD: {Decimal: ".", Thousand: ",", Code: NAD, Fraction: 2, NumericCode: "516", Grapheme: "$", Template: "$1", Name: "Namibian Dollar", PluralName: "Namibian dollars", meta: &currencyMeta{countries: []string{"NA"}}, Symbol: "NAD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	NGN: {Decimal: ".", Thousand: ",", Code: NGN, Fraction: 2, NumericCode: "566", Grapheme: "\u20a6", Template: "$1", Name: "Nigerian Naira", PluralName: "Nigerian nairas", meta: &currencyMeta{countries: []string{"NG"}}, Symbol: "NGN", NarrowSymbol: "\u20a6", AccountingFraction: 2, CashFraction: 2},
+	NIO: {Decimal: ".", Thousand: ",", Code: NIO, Fraction: 2, NumericCode: "558", Grapheme: "C$", Template: "$1", Name: "Nicaraguan Córdoba", PluralName: "Nicaraguan córdobas", meta: &currencyMeta{countries: []string{"NI"}}, Symbol: "NIO", NarrowSymbol: "C$", AccountingFraction: 2, CashFraction: 2},
+	NOK: {Decimal: ".", Thousand: ",", Code: NOK, Fraction: 2, NumericCode: "578", Grapheme: "kr", Template: "1 $", Name: "Norwegian Krone", PluralName: "Norwegian kroner", meta: &currencyMeta{countries: []string{"BV", "NO", "SJ"}}, Symbol: "NOK", NarrowSymbol: "kr", AccountingFraction: 2, CashFraction: 0, CashIncrement: 100},
+	NPR: {Decimal: ".", Thousand: ",", Code: NPR, Fraction: 2, NumericCode: "524", Grapheme: "\u20a8", Template: "$1", Name: "Nepalese Rupee", PluralName: "Nepalese rupees", meta: &currencyMeta{countries: []string{"NP"}}, Symbol: "NPR", NarrowSymbol: "\u20a8", AccountingFraction: 2, CashFraction: 2},
+	NZD: {Decimal: ".", Thousand: ",", Code: NZD, Fraction: 2, NumericCode: "554", Grapheme: "$", Template: "$1", Name: "New Zealand Dollar", PluralName: "New Zealand dollars", meta: &currencyMeta{countries: []string{"CK", "NU", "NZ", "PN", "TK"}}, Symbol: "NZ$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2, CashIncrement: 10},
+	OMR: {Decimal: ".", Thousand: ",", Code: OMR, Fraction: 3, NumericCode: "512", Grapheme: "\ufdfc", Template: "1 $", Name: "Omani Rial", PluralName: "Omani rials", meta: &currencyMeta{countries: []string{"OM"}}, Symbol: "OMR", NarrowSymbol: "\ufdfc", AccountingFraction: 3, CashFraction: 3},
+	PAB: {Decimal: ".", Thousand: ",", Code: PAB, Fraction: 2, NumericCode: "590", Grapheme: "B/.", Template: "$1", Name: "Panamanian Balboa", PluralName: "Panamanian balboas", meta: &currencyMeta{countries: []string{"PA"}}, Symbol: "PAB", NarrowSymbol: "B/.", AccountingFraction: 2, CashFraction: 2},
+	PEN: {Decimal: ".", Thousand: ",", Code: PEN, Fraction: 2, NumericCode: "604", Grapheme: "S/", Template: "$1", Name: "Peruvian Sol", PluralName: "Peruvian soles", meta: &currencyMeta{countries: []string{"PE"}}, Symbol: "PEN", NarrowSymbol: "S/", AccountingFraction: 2, CashFraction: 2},
+	PGK: {Decimal: ".", Thousand: ",", Code: PGK, Fraction: 2, NumericCode: "598", Grapheme: "K", Template: "1 $", Name: "Papua New Guinean Kina", PluralName: "Papua New Guinean kina", meta: &currencyMeta{countries: []string{"PG"}}, Symbol: "PGK", NarrowSymbol: "K", AccountingFraction: 2, CashFraction: 2},
+	PHP: {Decimal: ".", Thousand: ",", Code: PHP, Fraction: 2, NumericCode: "608", Grapheme: "\u20b1", Template: "$1", Name: "Philippine Peso", PluralName: "Philippine pesos", meta: &currencyMeta{countries: []string{"PH"}}, Symbol: "₱", NarrowSymbol: "₱", AccountingFraction: 2, CashFraction: 2},
+	PKR: {Decimal: ".", Thousand: ",", Code: PKR, Fraction: 2, NumericCode: "586", Grapheme: "\u20a8", Template: "$1", Name: "Pakistani Rupee", PluralName: "Pakistani rupees", meta: &currencyMeta{countries: []string{"PK"}}, Symbol: "PKR", NarrowSymbol: "\u20a8", AccountingFraction: 2, CashFraction: 0},
+	PLN: {Decimal: ".", Thousand: ",", Code: PLN, Fraction: 2, NumericCode: "985", Grapheme: "z\u0142", Template: "1 $", Name: "Polish Zloty", PluralName: "Polish zlotys", meta: &currencyMeta{countries: []string{"PL"}}, Symbol: "PLN", NarrowSymbol: "z\u0142", AccountingFraction: 2, CashFraction: 2},
+	PYG: {Decimal: ".", Thousand: ",", Code: PYG, Fraction: 0, NumericCode: "600", Grapheme: "Gs", Template: "1$", Name: "Paraguayan Guarani", PluralName: "Paraguayan guaranis", meta: &currencyMeta{countries: []string{"PY"}}, Symbol: "PYG", NarrowSymbol: "Gs", AccountingFraction: 0, CashFraction: 0},
+	QAR: {Decimal: ".", Thousand: ",", Code: QAR, Fraction: 2, NumericCode: "634", Grapheme: "\ufdfc", Template: "1 $", Name: "Qatari Riyal", PluralName: "Qatari riyals", meta: &currencyMeta{countries: []string{"QA"}}, Symbol: "QAR", NarrowSymbol: "\ufdfc", AccountingFraction: 2, CashFraction: 2},
+	RON: {Decimal: ".", Thousand: ",", Code: RON, Fraction: 2, NumericCode: "946", Grapheme: "lei", Template: "$1", Name: "Romanian Leu", PluralName: "Romanian lei", meta: &currencyMeta{countries: []string{"RO"}}, Symbol: "RON", NarrowSymbol: "lei", AccountingFraction: 2, CashFraction: 2},
+	RSD: {Decimal: ".", Thousand: ",", Code: RSD, Fraction: 2, NumericCode: "941", Grapheme: "\u0414\u0438\u043d.", Template: "$1", Name: "Serbian Dinar", PluralName: "Serbian dinars", meta: &currencyMeta{countries: []string{"RS"}}, Symbol: "RSD", NarrowSymbol: "\u0414\u0438\u043d.", AccountingFraction: 0, CashFraction: 0},
+	RUB: {Decimal: ".", Thousand: ",", Code: RUB, Fraction: 2, NumericCode: "643", Grapheme: "\u20bd", Template: "1 $", Name: "Russian Ruble", PluralName: "Russian rubles", meta: &currencyMeta{countries: []string{"RU"}}, Symbol: "RUB", NarrowSymbol: "\u20bd", AccountingFraction: 2, CashFraction: 2},
+	RUR: {Decimal: ".", Thousand: ",", Code: RUR, Fraction: 2, NumericCode: "", Grapheme: "\u20bd", Template: "1 $", Name: "Russian Ruble (1991–1998)", PluralName: "Russian rubles (1991–1998)", meta: &currencyMeta{countries: []string{"RU"}}, Symbol: "RUR", NarrowSymbol: "\u20bd", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(1998, 1, 1)},
+	RWF: {Decimal: ".", Thousand: ",", Code: RWF, Fraction: 0, NumericCode: "646", Grapheme: "FRw", Template: "1 $", Name: "Rwandan Franc", PluralName: "Rwandan francs", meta: &currencyMeta{countries: []string{"RW"}}, Symbol: "RWF", NarrowSymbol: "FRw", AccountingFraction: 0, CashFraction: 0},
+	SAR: {Decimal: ".", Thousand: ",", Code: SAR, Fraction: 2, NumericCode: "682", Grapheme: "\ufdfc", Template: "1 $", Name: "Saudi Riyal", PluralName: "Saudi riyals", meta: &currencyMeta{countries: []string{"SA"}}, Symbol: "SAR", NarrowSymbol: "\ufdfc", AccountingFraction: 2, CashFraction: 2},
+	SBD: {Decimal: ".", Thousand: ",", Code: SBD, Fraction: 2, NumericCode: "090", Grapheme: "$", Template: "$1", Name: "Solomon Islands Dollar", PluralName: "Solomon Islands dollars", meta: &currencyMeta{countries: []string{"SB"}}, Symbol: "SBD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	SCR: {Decimal: ".", Thousand: ",", Code: SCR, Fraction: 2, NumericCode: "690", Grapheme: "\u20a8", Template: "$1", Name: "Seychellois Rupee", PluralName: "Seychellois rupees", meta: &currencyMeta{countries: []string{"SC"}}, Symbol: "SCR", NarrowSymbol: "\u20a8", AccountingFraction: 2, CashFraction: 2},
+	SDG: {Decimal: ".", Thousand: ",", Code: SDG, Fraction: 2, NumericCode: "938", Grapheme: "\u00a3", Template: "$1", Name: "Sudanese Pound", PluralName: "Sudanese pounds", meta: &currencyMeta{countries: []string{"SD"}}, Symbol: "SDG", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	SEK: {Decimal: ".", Thousand: ",", Code: SEK, Fraction: 2, NumericCode: "752", Grapheme: "kr", Template: "1 $", Name: "Swedish Krona", PluralName: "Swedish kronor", meta: &currencyMeta{countries: []string{"SE"}}, Symbol: "SEK", NarrowSymbol: "kr", AccountingFraction: 2, CashFraction: 0, CashIncrement: 100},
+	SGD: {Decimal: ".", Thousand: ",", Code: SGD, Fraction: 2, NumericCode: "702", Grapheme: "$", Template: "$1", Name: "Singapore Dollar", PluralName: "Singapore dollars", meta: &currencyMeta{countries: []string{"SG"}}, Symbol: "SGD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	SHP: {Decimal: ".", Thousand: ",", Code: SHP, Fraction: 2, NumericCode: "654", Grapheme: "\u00a3", Template: "$1", Name: "St. Helena Pound", PluralName: "St. Helena pounds", meta: &currencyMeta{countries: []string{"SH"}}, Symbol: "SHP", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	SKK: {Decimal: ".", Thousand: ",", Code: SKK, Fraction: 2, NumericCode: "", Grapheme: "Sk", Template: "$1", Name: "Slovak Koruna", PluralName: "Slovak korunas", meta: &currencyMeta{countries: []string{"SK"}}, Symbol: "SKK", NarrowSymbol: "Sk", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2009, 1, 1)},
+	SLE: {Decimal: ".", Thousand: ",", Code: SLE, Fraction: 2, NumericCode: "925", Grapheme: "Le", Template: "1 $", Name: "Sierra Leonean Leone", PluralName: "Sierra Leonean leones", meta: &currencyMeta{countries: []string{"SL"}}, Symbol: "SLE", NarrowSymbol: "Le", AccountingFraction: 2, CashFraction: 2},
+	SLL: {Decimal: ".", Thousand: ",", Code: SLL, Fraction: 2, NumericCode: "694", Grapheme: "Le", Template: "1 $", Name: "Sierra Leonean Leone (1964–2022)", PluralName: "Sierra Leonean leones (1964–2022)", meta: &currencyMeta{countries: []string{"SL"}}, Symbol: "SLL", NarrowSymbol: "Le", AccountingFraction: 0, CashFraction: 0},
+	SOS: {Decimal: ".", Thousand: ",", Code: SOS, Fraction: 2, NumericCode: "706", Grapheme: "Sh", Template: "1 $", Name: "Somali Shilling", PluralName: "Somali shillings", meta: &currencyMeta{countries: []string{"SO"}}, Symbol: "SOS", NarrowSymbol: "Sh", AccountingFraction: 0, CashFraction: 0},
+	SRD: {Decimal: ".", Thousand: ",", Code: SRD, Fraction: 2, NumericCode: "968", Grapheme: "$", Template: "$1", Name: "Surinamese Dollar", PluralName: "Surinamese dollars", meta: &currencyMeta{countries: []string{"SR"}}, Symbol: "SRD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	SSP: {Decimal: ".", Thousand: ",", Code: SSP, Fraction: 2, NumericCode: "728", Grapheme: "\u00a3", Template: "1 $", Name: "South Sudanese Pound", PluralName: "South Sudanese pounds", meta: &currencyMeta{countries: []string{"SS"}}, Symbol: "SSP", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	STD: {Decimal: ".", Thousand: ",", Code: STD, Fraction: 2, NumericCode: "", Grapheme: "Db", Template: "1 $", Name: "São Tomé & Príncipe Dobra (1977–2017)", PluralName: "São Tomé & Príncipe dobras (1977–2017)", meta: &currencyMeta{countries: []string{"ST"}}, Symbol: "STD", NarrowSymbol: "Db", AccountingFraction: 0, CashFraction: 0, Status: CurrencyWithdrawn, ValidTo: day(2018, 1, 1)},
+	STN: {Decimal: ".", Thousand: ",", Code: STN, Fraction: 2, NumericCode: "930", Grapheme: "Db", Template: "1 $", Name: "São Tomé & Príncipe Dobra", PluralName: "São Tomé & Príncipe dobras", meta: &currencyMeta{countries: []string{"ST"}}, Symbol: "STN", NarrowSymbol: "Db", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2018, 1, 1)},
+	SVC: {Decimal: ".", Thousand: ",", Code: SVC, Fraction: 2, NumericCode: "222", Grapheme: "\u20a1", Template: "$1", Name: "Salvadoran Colón", PluralName: "Salvadoran colones", meta: &currencyMeta{countries: []string{"SV"}}, Symbol: "SVC", NarrowSymbol: "\u20a1", AccountingFraction: 2, CashFraction: 2},
+	SYP: {Decimal: ".", Thousand: ",", Code: SYP, Fraction: 2, NumericCode: "760", Grapheme: "\u00a3", Template: "1 $", Name: "Syrian Pound", PluralName: "Syrian pounds", meta: &currencyMeta{countries: []string{"SY"}}, Symbol: "SYP", NarrowSymbol: "\u00a3", AccountingFraction: 0, CashFraction: 0},
+	SZL: {Decimal: ".", Thousand: ",", Code: SZL, Fraction: 2, NumericCode: "748", Grapheme: "\u00a3", Template: "$1", Name: "Swazi Lilangeni", PluralName: "Swazi emalangeni", meta: &currencyMeta{countries: []string{"SZ"}}, Symbol: "SZL", NarrowSymbol: "\u00a3", AccountingFraction: 2, CashFraction: 2},
+	THB: {Decimal: ".", Thousand: ",", Code: THB, Fraction: 2, NumericCode: "764", Grapheme: "\u0e3f", Template: "$1", Name: "Thai Baht", PluralName: "Thai baht", meta: &currencyMeta{countries: []string{"TH"}}, Symbol: "THB", NarrowSymbol: "\u0e3f", AccountingFraction: 2, CashFraction: 2},
+	TJS: {Decimal: ".", Thousand: ",", Code: TJS, Fraction: 2, NumericCode: "972", Grapheme: "SM", Template: "1 $", Name: "Tajikistani Somoni", PluralName: "Tajikistani somonis", meta: &currencyMeta{countries: []string{"TJ"}}, Symbol: "TJS", NarrowSymbol: "SM", AccountingFraction: 2, CashFraction: 2},
+	TMT: {Decimal: ".", Thousand: ",", Code: TMT, Fraction: 2, NumericCode: "934", Grapheme: "T", Template: "1 $", Name: "Turkmenistani Manat", PluralName: "Turkmenistani manat", meta: &currencyMeta{countries: []string{"TM"}}, Symbol: "TMT", NarrowSymbol: "T", AccountingFraction: 2, CashFraction: 2},
+	TND: {Decimal: ".", Thousand: ",", Code: TND, Fraction: 3, NumericCode: "788", Grapheme: ".\u062f.\u062a", Template: "1 $", Name: "Tunisian Dinar", PluralName: "Tunisian dinars", meta: &currencyMeta{countries: []string{"TN"}}, Symbol: "TND", NarrowSymbol: ".\u062f.\u062a", AccountingFraction: 3, CashFraction: 3},
+	TOP: {Decimal: ".", Thousand: ",", Code: TOP, Fraction: 2, NumericCode: "776", Grapheme: "T$", Template: "$1", Name: "Tongan Paʻanga", PluralName: "Tongan paʻanga", meta: &currencyMeta{countries: []string{"TO"}}, Symbol: "TOP", NarrowSymbol: "T$", AccountingFraction: 2, CashFraction: 2},
+	TRL: {Decimal: ".", Thousand: ",", Code: TRL, Fraction: 2, NumericCode: "", Grapheme: "\u20a4", Template: "$1", Name: "Turkish Lira (1922–2005)", PluralName: "Turkish Lira (1922–2005)", meta: &currencyMeta{countries: []string{"TR"}}, Symbol: "TRL", NarrowSymbol: "\u20a4", AccountingFraction: 0, CashFraction: 0, Status: CurrencyWithdrawn, ValidTo: day(2005, 1, 1)},
+	TRY: {Decimal: ".", Thousand: ",", Code: TRY, Fraction: 2, NumericCode: "949", Grapheme: "\u20ba", Template: "$1", Name: "Turkish Lira", PluralName: "Turkish Lira", meta: &currencyMeta{countries: []string{"TR"}}, Symbol: "TRY", NarrowSymbol: "\u20ba", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2005, 1, 1)},
+	TTD: {Decimal: ".", Thousand: ",", Code: TTD, Fraction: 2, NumericCode: "780", Grapheme: "TT$", Template: "$1", Name: "Trinidad & Tobago Dollar", PluralName: "Trinidad & Tobago dollars", meta: &currencyMeta{countries: []string{"TT"}}, Symbol: "TTD", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	TWD: {Decimal: ".", Thousand: ",", Code: TWD, Fraction: 2, NumericCode: "901", Grapheme: "NT$", Template: "$1", Name: "New Taiwan Dollar", PluralName: "New Taiwan dollars", meta: &currencyMeta{countries: []string{"TW"}}, Symbol: "NT$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 0},
+	TZS: {Decimal: ".", Thousand: ",", Code: TZS, Fraction: 2, NumericCode: "834", Grapheme: "TSh", Template: "$1", Name: "Tanzanian Shilling", PluralName: "Tanzanian shillings", meta: &currencyMeta{countries: []string{"TZ"}}, Symbol: "TZS", NarrowSymbol: "TSh", AccountingFraction: 2, CashFraction: 0},
+	UAH: {Decimal: ".", Thousand: ",", Code: UAH, Fraction: 2, NumericCode: "980", Grapheme: "\u20b4", Template: "1 $", Name: "Ukrainian Hryvnia", PluralName: "Ukrainian hryvnias", meta: &currencyMeta{countries: []string{"UA"}}, Symbol: "UAH", NarrowSymbol: "\u20b4", AccountingFraction: 2, CashFraction: 2},
+	UGX: {Decimal: ".", Thousand: ",", Code: UGX, Fraction: 0, NumericCode: "800", Grapheme: "USh", Template: "1 $", Name: "Ugandan Shilling", PluralName: "Ugandan shillings", meta: &currencyMeta{countries: []string{"UG"}, exponents: map[string]int{"stripe": 2}}, Symbol: "UGX", NarrowSymbol: "USh", AccountingFraction: 0, CashFraction: 0},
+	USD: {Decimal: ".", Thousand: ",", Code: USD, Fraction: 2, NumericCode: "840", Grapheme: "$", Template: "$1", Name: "US Dollar", PluralName: "US dollars", meta: &currencyMeta{countries: []string{"AS", "BQ", "EC", "FM", "GU", "HT", "IO", "MH", "MP", "PA", "PR", "PW", "SV", "TC", "TL", "UM", "US", "VG", "VI"}}, Symbol: "US$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	UYU: {Decimal: ".", Thousand: ",", Code: UYU, Fraction: 2, NumericCode: "858", Grapheme: "$U", Template: "$1", Name: "Uruguayan Peso", PluralName: "Uruguayan pesos", meta: &currencyMeta{countries: []string{"UY"}}, Symbol: "UYU", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	UZS: {Decimal: ".", Thousand: ",", Code: UZS, Fraction: 2, NumericCode: "860", Grapheme: "so\u2019m", Template: "$1", Name: "Uzbekistani Som", PluralName: "Uzbekistani som", meta: &currencyMeta{countries: []string{"UZ"}}, Symbol: "UZS", NarrowSymbol: "so\u2019m", AccountingFraction: 2, CashFraction: 0},
+	VEF: {Decimal: ".", Thousand: ",", Code: VEF, Fraction: 2, NumericCode: "937", Grapheme: "Bs", Template: "$1", Name: "Venezuelan Bolívar (2008–2018)", PluralName: "Venezuelan bolívars (2008–2018)", meta: &currencyMeta{countries: []string{"VE"}}, Symbol: "VEF", NarrowSymbol: "Bs", AccountingFraction: 2, CashFraction: 0, Status: CurrencyWithdrawn, ValidTo: day(2018, 8, 20)},
+	VES: {Decimal: ".", Thousand: ",", Code: VES, Fraction: 2, NumericCode: "928", Grapheme: "Bs.S", Template: "$1", Name: "Venezuelan Bolívar", PluralName: "Venezuelan bolívars", meta: &currencyMeta{countries: []string{"VE"}}, Symbol: "VES", NarrowSymbol: "Bs.S", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2018, 8, 20)},
+	VND: {Decimal: ".", Thousand: ",", Code: VND, Fraction: 0, NumericCode: "704", Grapheme: "\u20ab", Template: "1 $", Name: "Vietnamese Dong", PluralName: "Vietnamese dong", meta: &currencyMeta{countries: []string{"VN"}}, Symbol: "₫", NarrowSymbol: "₫", AccountingFraction: 0, CashFraction: 0},
+	VUV: {Decimal: ".", Thousand: ",", Code: VUV, Fraction: 0, NumericCode: "548", Grapheme: "Vt", Template: "$1", Name: "Vanuatu Vatu", PluralName: "Vanuatu vatus", meta: &currencyMeta{countries: []string{"VU"}}, Symbol: "VUV", NarrowSymbol: "Vt", AccountingFraction: 0, CashFraction: 0},
+	WST: {Decimal: ".", Thousand: ",", Code: WST, Fraction: 2, NumericCode: "882", Grapheme: "T", Template: "1 $", Name: "Samoan Tala", PluralName: "Samoan tala", meta: &currencyMeta{countries: []string{"WS"}}, Symbol: "WST", NarrowSymbol: "T", AccountingFraction: 2, CashFraction: 2},
+	XAF: {Decimal: ".", Thousand: ",", Code: XAF, Fraction: 0, NumericCode: "950", Grapheme: "Fr", Template: "1 $", Name: "Central African CFA Franc", PluralName: "Central African CFA francs", meta: &currencyMeta{countries: []string{"CF", "CG", "CM", "GA", "GQ", "TD"}}, Symbol: "FCFA", NarrowSymbol: "Fr", AccountingFraction: 0, CashFraction: 0},
+	XAG: {Decimal: ".", Thousand: ",", Code: XAG, Fraction: 0, NumericCode: "961", Grapheme: "oz t", Template: "1 $", Name: "Silver", PluralName: "troy ounces of silver", Symbol: "XAG", NarrowSymbol: "oz t", AccountingFraction: 0, CashFraction: 0},
+	XAU: {Decimal: ".", Thousand: ",", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "oz t", Template: "1 $", Name: "Gold", PluralName: "troy ounces of gold", Symbol: "XAU", NarrowSymbol: "oz t", AccountingFraction: 0, CashFraction: 0},
+	XCD: {Decimal: ".", Thousand: ",", Code: XCD, Fraction: 2, NumericCode: "951", Grapheme: "$", Template: "$1", Name: "East Caribbean Dollar", PluralName: "East Caribbean dollars", meta: &currencyMeta{countries: []string{"AG", "AI", "DM", "GD", "KN", "LC", "MS", "VC"}}, Symbol: "EC$", NarrowSymbol: "$", AccountingFraction: 2, CashFraction: 2},
+	XDR: {Decimal: ".", Thousand: ",", Code: XDR, Fraction: 0, NumericCode: "960", Grapheme: "SDR", Template: "1 $", Name: "Special Drawing Rights", PluralName: "special drawing rights", Symbol: "XDR", NarrowSymbol: "SDR", AccountingFraction: 0, CashFraction: 0},
+	XOF: {Decimal: ".", Thousand: ",", Code: XOF, Fraction: 0, NumericCode: "952", Grapheme: "CFA", Template: "1 $", Name: "West African CFA Franc", PluralName: "West African CFA francs", meta: &currencyMeta{countries: []string{"BF", "BJ", "CI", "GW", "ML", "NE", "SN", "TG"}}, Symbol: "F CFA", NarrowSymbol: "CFA", AccountingFraction: 0, CashFraction: 0},
+	XPF: {Decimal: ".", Thousand: ",", Code: XPF, Fraction: 0, NumericCode: "953", Grapheme: "₣", Template: "1 $", Name: "CFP Franc", PluralName: "CFP francs", meta: &currencyMeta{countries: []string{"NC", "PF", "WF"}}, Symbol: "CFPF", NarrowSymbol: "₣", AccountingFraction: 0, CashFraction: 0},
+	YER: {Decimal: ".", Thousand: ",", Code: YER, Fraction: 2, NumericCode: "886", Grapheme: "\ufdfc", Template: "1 $", Name: "Yemeni Rial", PluralName: "Yemeni rials", meta: &currencyMeta{countries: []string{"YE"}}, Symbol: "YER", NarrowSymbol: "\ufdfc", AccountingFraction: 0, CashFraction: 0},
+	ZAR: {Decimal: ".", Thousand: ",", Code: ZAR, Fraction: 2, NumericCode: "710", Grapheme: "R", Template: "$1", Name: "South African Rand", PluralName: "South African rand", meta: &currencyMeta{countries: []string{"LS", "NA", "ZA"}}, Symbol: "ZAR", NarrowSymbol: "R", AccountingFraction: 2, CashFraction: 2},
+	ZMW: {Decimal: ".", Thousand: ",", Code: ZMW, Fraction: 2, NumericCode: "967", Grapheme: "ZK", Template: "$1", Name: "Zambian Kwacha", PluralName: "Zambian kwachas", meta: &currencyMeta{countries: []string{"ZM"}}, Symbol: "ZMW", NarrowSymbol: "ZK", AccountingFraction: 2, CashFraction: 2},
+	ZWD: {Decimal: ".", Thousand: ",", Code: ZWD, Fraction: 2, NumericCode: "716", Grapheme: "Z$", Template: "$1", Name: "Zimbabwean Dollar (1980–2008)", PluralName: "Zimbabwean dollars (1980–2008)", meta: &currencyMeta{countries: []string{"ZW"}}, Symbol: "ZWD", NarrowSymbol: "Z$", AccountingFraction: 0, CashFraction: 0, Status: CurrencyWithdrawn, ValidTo: day(2006, 8, 1)},
+	ZWG: {Decimal: ".", Thousand: ",", Code: ZWG, Fraction: 2, NumericCode: "924", Grapheme: "ZiG", Template: "1 $", Name: "Zimbabwean Gold", PluralName: "Zimbabwean gold", meta: &currencyMeta{countries: []string{"ZW"}}, Symbol: "ZWG", NarrowSymbol: "ZiG", AccountingFraction: 2, CashFraction: 2, ValidFrom: day(2024, 4, 5)},
+	ZWL: {Decimal: ".", Thousand: ",", Code: ZWL, Fraction: 2, NumericCode: "932", Grapheme: "Z$", Template: "$1", Name: "Zimbabwean Dollar (2009–2024)", PluralName: "Zimbabwean dollars (2009–2024)", meta: &currencyMeta{countries: []string{"ZW"}}, Symbol: "ZWL", NarrowSymbol: "Z$", AccountingFraction: 2, CashFraction: 2, Status: CurrencyWithdrawn, ValidTo: day(2024, 9, 1)},
+}
+
+// AddCurrency lets you insert or update currency in the default Registry.
+// It can't set a numeric code, use RegisterCurrency for currencies having one.
 func AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int) *Currency {
-	c := Currency{
-		Code:     code,
-		Grapheme: Grapheme,
-		Template: Template,
-		Decimal:  Decimal,
-		Thousand: Thousand,
-		Fraction: Fraction,
-	}
-	currencies.Add(&c)
-	return &c
+	return defaultRegistry.AddCurrency(code, Grapheme, Template, Decimal, Thousand, Fraction)
 }
 
 func newCurrency(code string) *Currency {
 	return &Currency{Code: strings.ToUpper(code)}
 }
 
+// RegisterCurrency inserts or updates a copy of the given Currency in the default Registry.
+// It returns ErrDuplicateNumericCode when another currency has the same numeric code, see Registry.Register.
+func RegisterCurrency(currency *Currency) error {
+	return defaultRegistry.Register(currency)
+}
+
 // GetCurrency returns the currency given the code.
 func GetCurrency(code string) *Currency {
-	return currencies.CurrencyByCode(strings.ToUpper(code))
+	return defaultRegistry.GetCurrency(code)
+}
+
+// GetCurrencyAt returns the currency given the code if it's in use at the given date, see Currency.ActiveAt.
+func GetCurrencyAt(code string, date time.Time) *Currency {
+	return defaultRegistry.GetCurrencyAt(code, date)
+}
+
+// CurrenciesForCountry returns the currencies in use in the country given its ISO 3166 alpha-2 code,
+// sorted by currency code.
+func CurrenciesForCountry(country string) []*Currency {
+	return defaultRegistry.CurrenciesForCountry(country)
+}
+
+// CountryCurrencies returns the currencies in use in every country, by ISO 3166 alpha-2 code.
+func CountryCurrencies() map[string][]*Currency {
+	return defaultRegistry.CountryCurrencies()
 }
 
 // Formatter returns currency formatter representing
 // used currency structure.
 func (c *Currency) Formatter() *Formatter {
+	if c == nil {
+		c = c.get()
+	}
+
 	return &Formatter{
 		Fraction: c.Fraction,
 		Decimal:  c.Decimal,
@@ -262,18 +409,150 @@ func (c *Currency) Formatter() *Formatter {
 // getDefault represent default currency if currency is not found in currencies list.
 // Grapheme and Code fields will be changed by currency code.
 func (c *Currency) getDefault() *Currency {
-	return &Currency{Decimal: ".", Thousand: ",", Code: c.Code, Fraction: 2, Grapheme: c.Code, Template: "1$"}
+	code := codeOf(c)
+	return &Currency{Decimal: ".", Thousand: ",", Code: code, Fraction: 2, AccountingFraction: 2, CashFraction: 2,
+		Grapheme: code, Template: "1$", registry: c.registryOrNil()}
 }
 
-// get extended currency using currencies list.
+// get extended currency using the currencies list of the Registry it belongs to.
+// The nil currency of zero value Money gets the default currency without a code.
 func (c *Currency) get() *Currency {
-	if curr, ok := currencies[c.Code]; ok {
-		return curr
+	if c == nil {
+		return c.getDefault()
+	}
+
+	return c.registry.get(c.Code)
+}
+
+func (c *Currency) registryOrNil() *Registry {
+	if c == nil {
+		return nil
+	}
+
+	return c.registry
+}
+
+// ActiveAt reports whether the currency is in use at the given time.
+// Withdrawn currencies are active before their ValidTo date, if it's known.
+func (c *Currency) ActiveAt(t time.Time) bool {
+	if c == nil || c.withdrawnAt(t) {
+		return false
+	}
+
+	return c.ValidFrom.IsZero() || !t.Before(c.ValidFrom)
+}
+
+// withdrawnAt reports whether the currency is no longer in use at the given time.
+func (c *Currency) withdrawnAt(t time.Time) bool {
+	if !c.ValidTo.IsZero() {
+		return !t.Before(c.ValidTo)
+	}
+
+	return c.Status == CurrencyWithdrawn
+}
+
+// Countries returns the ISO 3166 alpha-2 codes of the countries using the currency.
+func (c *Currency) Countries() []string {
+	if c == nil || c.meta == nil {
+		return nil
+	}
+
+	return append([]string(nil), c.meta.countries...)
+}
+
+// WithCountries returns a copy of the currency used by the countries given their ISO 3166 alpha-2 codes,
+// e.g. NewRegistry((&Currency{Code: "XYZ"}).WithCountries("XA")).
+func (c *Currency) WithCountries(countries ...string) *Currency {
+	cc := c.copy()
+	cc.meta.countries = append([]string(nil), countries...)
+	return cc
+}
+
+// Units returns the alternate units amounts of the currency can be displayed in, e.g. sat or gwei, see Unit.
+func (c *Currency) Units() []Unit {
+	if c == nil || c.meta == nil {
+		return nil
+	}
+
+	return append([]Unit(nil), c.meta.units...)
+}
+
+// WithUnits returns a copy of the currency with the given alternate units, see Unit.
+func (c *Currency) WithUnits(units ...Unit) *Currency {
+	cc := c.copy()
+	cc.meta.units = append([]Unit(nil), units...)
+	return cc
+}
+
+// copy returns a copy of the currency with its own data, for the With methods. A nil currency is empty.
+func (c *Currency) copy() *Currency {
+	cc := Currency{}
+	if c != nil {
+		cc = *c
+	}
+
+	cc.meta = cc.meta.clone()
+	if cc.meta == nil {
+		cc.meta = &currencyMeta{}
+	}
+
+	return &cc
+}
+
+// Exponent returns the exponent amounts of the currency have in the given payment scheme,
+// which is the fraction unless the scheme has its own, e.g. 2 in the "stripe" scheme for the
+// Stripe API taking ISK and UGX amounts with 2 decimals. See WithExponent.
+func (c *Currency) Exponent(scheme string) int {
+	c = c.get()
+	if c.meta != nil {
+		if e, ok := c.meta.exponents[scheme]; ok {
+			return e
+		}
+	}
+
+	return c.Fraction
+}
+
+// WithExponent returns a copy of the currency whose amounts have the given exponent in the payment scheme.
+func (c *Currency) WithExponent(scheme string, exponent int) *Currency {
+	cc := c.copy()
+	if cc.meta.exponents == nil {
+		cc.meta.exponents = make(map[string]int)
+	}
+
+	cc.meta.exponents[scheme] = exponent
+	return cc
+}
+
+// cashIncrement returns the smallest amount in subunits that can be paid in cash.
+func (c *Currency) cashIncrement() int64 {
+	if c == nil {
+		return 1
+	}
+
+	if c.CashIncrement > 0 {
+		return c.CashIncrement
+	}
+
+	return c.increment(c.CashFraction)
+}
+
+// increment returns the subunits of the smallest amount of the given fraction,
+// 1 when the fraction isn't below Fraction.
+func (c *Currency) increment(fraction int) int64 {
+	if c == nil || fraction < 0 || fraction >= c.Fraction {
+		return 1
+	}
+
+	inc, err := mutate.calc.pow10(c.Fraction - fraction)
+	if err != nil {
+		// The difference is above 18 decimals, no amount but zero is a multiple of it.
+		return math.MaxInt64
 	}
 
-	return c.getDefault()
+	return inc
 }
 
 func (c *Currency) equals(oc *Currency) bool {
-	return c.Code == oc.Code
+	return codeOf(c) == codeOf(oc)
 }
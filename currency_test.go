@@ -1,8 +1,13 @@
 package money
 
 import (
+	"errors"
+	"os"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestCurrency_Get(t *testing.T) {
@@ -74,7 +79,7 @@ func TestCurrency_AddCurrency(t *testing.T) {
 
 func TestCurrency_GetCurrency(t *testing.T) {
 	code := "KLINGONDOLLAR"
-	desired := Currency{Decimal: ".", Thousand: ",", Code: code, Fraction: 2, Grapheme: "$", Template: "$1"}
+	desired := Currency{Decimal: ".", Thousand: ",", Code: code, Fraction: 2, AccountingFraction: 2, CashFraction: 2, Grapheme: "$", Template: "$1"}
 	AddCurrency(desired.Code, desired.Grapheme, desired.Template, desired.Decimal, desired.Thousand, desired.Fraction)
 	currency := GetCurrency(code)
 	if !reflect.DeepEqual(currency, &desired) {
@@ -136,4 +141,319 @@ func TestCurrencies(t *testing.T) {
 	if !curBar.equals(ac) {
 		t.Errorf("unexpected currency returned. expected: %v, got %v", curBar, ac)
 	}
+
+	curBaz := &Currency{Code: "BAZ", NumericCode: "036"}
+	cs = cs.Add(curBaz).Add(&Currency{Code: "QUX"})
+	for _, code := range []string{"036", "36"} {
+		if ac = cs.CurrencyByNumericCode(code); !curBaz.equals(ac) {
+			t.Errorf("unexpected currency returned for %s. expected: %v, got %v", code, curBaz, ac)
+		}
+	}
+
+	if ac = cs.CurrencyByNumericCode(""); ac != nil {
+		t.Errorf("unexpected currency returned for an empty numeric code: %v", ac)
+	}
+}
+
+func TestCurrency_ActiveAt(t *testing.T) {
+	tcs := []struct {
+		code   string
+		date   time.Time
+		active bool
+	}{
+		{EUR, time.Now(), true},
+		{BYR, day(2016, 12, 31), true},
+		{BYR, day(2017, 1, 1), false},
+		{BYN, day(2016, 6, 30), false},
+		{BYN, day(2016, 7, 1), true},
+		{GHC, day(2005, 1, 1), true},
+		{GHC, day(2007, 7, 1), false},
+		{GHS, day(2007, 6, 30), false},
+		{GHS, day(2007, 7, 1), true},
+		{HRK, day(2022, 12, 31), true},
+		{HRK, time.Now(), false},
+		{ZWD, time.Now(), false},
+	}
+
+	for _, tc := range tcs {
+		if GetCurrency(tc.code).ActiveAt(tc.date) != tc.active {
+			t.Errorf("Expected %s to be active %v at %s", tc.code, tc.active, tc.date)
+		}
+
+		if c := GetCurrencyAt(tc.code, tc.date); (c != nil) != tc.active {
+			t.Errorf("Expected %s at %s got %v", tc.code, tc.date, c)
+		}
+	}
+
+	withdrawn := &Currency{Code: "OLD", Status: CurrencyWithdrawn}
+	if withdrawn.ActiveAt(time.Time{}) || (*Currency)(nil).ActiveAt(time.Now()) {
+		t.Error("Expected a withdrawn currency without ValidTo and a nil currency never to be active")
+	}
+}
+
+func TestRegistry_ActiveAt(t *testing.T) {
+	cs := DefaultRegistry().ActiveAt(day(2010, 1, 1))
+
+	for _, code := range []string{EUR, BYR, TRY, VEF} {
+		if cs.CurrencyByCode(code) == nil {
+			t.Errorf("Expected %s to be active in 2010", code)
+		}
+	}
+
+	for _, code := range []string{BYN, TRL, VES, SKK} {
+		if cs.CurrencyByCode(code) != nil {
+			t.Errorf("Expected %s not to be active in 2010", code)
+		}
+	}
+}
+
+func TestNewStrict_Withdrawn(t *testing.T) {
+	for _, code := range []string{BYR, ZWD, HRK} {
+		if _, err := NewStrict(100, code); !errors.Is(err, ErrWithdrawnCurrency) {
+			t.Errorf("Expected %v for %s got %v", ErrWithdrawnCurrency, code, err)
+		}
+
+		if _, err := Parse("1", code); !errors.Is(err, ErrWithdrawnCurrency) {
+			t.Errorf("Expected %v for %s got %v", ErrWithdrawnCurrency, code, err)
+		}
+
+		// Historical records can still be read.
+		var m Money
+		if err := m.Scan("100|" + code); err != nil || m.Currency().Code != code {
+			t.Errorf("Expected to scan 100 %s got %v, %v", code, m, err)
+		}
+
+		if New(100, code).Currency().Code != code {
+			t.Errorf("Expected New to accept %s", code)
+		}
+	}
+
+	r := NewRegistry(&Currency{Code: "NEW", ValidTo: time.Now().Add(time.Hour), Status: CurrencyWithdrawn})
+	if _, err := r.NewStrict(100, "NEW"); err != nil {
+		t.Errorf("Expected a currency withdrawn in the future to be accepted got %v", err)
+	}
+}
+
+func TestCurrency_Info(t *testing.T) {
+	// Other tests add currencies, the built-in ones are read from the source.
+	src, err := os.ReadFile("currency.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range regexp.MustCompile(`(?m)^\t([A-Z]{3}): \{`).FindAllStringSubmatch(string(src), -1) {
+		c := GetCurrency(m[1])
+		if c.Name == "" || c.PluralName == "" || c.Symbol == "" || c.NarrowSymbol == "" {
+			t.Errorf("Expected names and symbols for %s got %+v", m[1], c)
+		}
+	}
+
+	tcs := []struct {
+		code         string
+		name         string
+		plural       string
+		symbol       string
+		narrowSymbol string
+	}{
+		{USD, "US Dollar", "US dollars", "US$", "$"},
+		{CAD, "Canadian Dollar", "Canadian dollars", "CA$", "$"},
+		{EUR, "Euro", "euros", "€", "€"},
+		{CHF, "Swiss Franc", "Swiss francs", "CHF", "CHF"},
+		{SEK, "Swedish Krona", "Swedish kronor", "SEK", "kr"},
+	}
+
+	for _, tc := range tcs {
+		c := GetCurrency(tc.code)
+		if c.Name != tc.name || c.PluralName != tc.plural || c.Symbol != tc.symbol || c.NarrowSymbol != tc.narrowSymbol {
+			t.Errorf("Expected %s %s %s %s got %s %s %s %s", tc.name, tc.plural, tc.symbol, tc.narrowSymbol,
+				c.Name, c.PluralName, c.Symbol, c.NarrowSymbol)
+		}
+	}
+}
+
+func TestCurrenciesForCountry(t *testing.T) {
+	tcs := []struct {
+		country  string
+		expected []string
+	}{
+		{"CH", []string{CHF}},
+		{"li", []string{CHF}},
+		{"HR", []string{EUR}},
+		{"BY", []string{BYN}},
+		{"PA", []string{PAB, USD}},
+		{"CU", []string{CUC, CUP}},
+		{"ZZ", nil},
+		{"", nil},
+	}
+
+	for _, tc := range tcs {
+		cs := CurrenciesForCountry(tc.country)
+
+		codes := make([]string, 0, len(cs))
+		for _, c := range cs {
+			codes = append(codes, c.Code)
+		}
+
+		if len(codes) != len(tc.expected) || (len(codes) > 0 && !reflect.DeepEqual(codes, tc.expected)) {
+			t.Errorf("Expected %v for %q got %v", tc.expected, tc.country, codes)
+		}
+	}
+}
+
+func TestCountryCurrencies(t *testing.T) {
+	byCountry := CountryCurrencies()
+
+	if len(byCountry["FR"]) != 1 || byCountry["FR"][0].Code != EUR {
+		t.Errorf("Expected FR to use EUR got %v", byCountry["FR"])
+	}
+
+	if len(byCountry["ZW"]) != 1 || byCountry["ZW"][0].Code != ZWG {
+		t.Errorf("Expected ZW to use ZWG got %v", byCountry["ZW"])
+	}
+
+	for country, cs := range byCountry {
+		if len(country) != 2 || strings.ToUpper(country) != country {
+			t.Errorf("Expected an ISO 3166 alpha-2 code got %q", country)
+		}
+
+		for _, c := range cs {
+			if !c.ActiveAt(time.Now()) {
+				t.Errorf("Expected only currencies in use got %s for %s", c.Code, country)
+			}
+		}
+	}
+
+	abc := (&Currency{Code: "ABC"}).WithCountries("XA")
+	r := NewRegistry(abc)
+	if cs := r.CurrenciesForCountry("XA"); len(cs) != 1 || cs[0].Code != "ABC" {
+		t.Errorf("Expected ABC for XA got %v", cs)
+	}
+
+	// The countries of the registered copy can't be changed through the currency it was made of.
+	abc.Countries()[0] = "XB"
+	if cs := abc.WithCountries("XC").Countries(); len(cs) != 1 || cs[0] != "XC" || abc.Countries()[0] != "XA" {
+		t.Errorf("Expected WithCountries to return a copy got %v and %v", cs, abc.Countries())
+	}
+
+	if cs := r.Clone().CurrencyByCode("ABC").Countries(); len(cs) != 1 || cs[0] != "XA" {
+		t.Errorf("Expected XA got %v", cs)
+	}
+
+	if cs := (*Currency)(nil).Countries(); cs != nil {
+		t.Errorf("Expected no countries got %v", cs)
+	}
+}
+
+func TestCurrency_Fractions(t *testing.T) {
+	tcs := []struct {
+		code       string
+		fraction   int
+		accounting int
+		cash       int
+	}{
+		{EUR, 2, 2, 2},
+		{HUF, 2, 2, 0},
+		{TWD, 2, 2, 0},
+		{SEK, 2, 2, 0},
+		{ISK, 0, 0, 0},
+		{JPY, 0, 0, 0},
+		{IQD, 3, 0, 0},
+		{ALL, 2, 0, 0},
+		{COP, 2, 2, 0},
+		{"XYZ", 2, 2, 2},
+	}
+
+	for _, tc := range tcs {
+		c := newCurrency(tc.code).get()
+		if c.Fraction != tc.fraction || c.AccountingFraction != tc.accounting || c.CashFraction != tc.cash {
+			t.Errorf("Expected %s fractions %d/%d/%d got %d/%d/%d", tc.code, tc.fraction, tc.accounting, tc.cash,
+				c.Fraction, c.AccountingFraction, c.CashFraction)
+		}
+	}
+
+	c := NewRegistry().AddCurrency("ABC", "A", "$1", ".", ",", 3)
+	if c.AccountingFraction != 3 || c.CashFraction != 3 {
+		t.Errorf("Expected AddCurrency to set the fractions to 3 got %d/%d", c.AccountingFraction, c.CashFraction)
+	}
+
+	// Unset fractions default to the built-in ones for the same fraction, or else to the fraction.
+	rtcs := []struct {
+		currency   *Currency
+		accounting int
+		cash       int
+	}{
+		{&Currency{Code: "ABC", Fraction: 3}, 3, 3},
+		{&Currency{Code: HUF, Fraction: 2}, 2, 0},
+		{&Currency{Code: ALL, Fraction: 2}, 0, 0},
+		{&Currency{Code: HUF, Fraction: 3}, 3, 3},
+		{&Currency{Code: "ABC", Fraction: 3, CashFraction: 1}, 0, 1},
+		{&Currency{Code: "ABC", Fraction: 0}, 0, 0},
+	}
+
+	for _, tc := range rtcs {
+		for _, r := range []*Registry{NewRegistry(tc.currency), NewRegistry()} {
+			if err := r.Register(tc.currency); err != nil {
+				t.Fatal(err)
+			}
+
+			c := r.CurrencyByCode(tc.currency.Code)
+			if c.AccountingFraction != tc.accounting || c.CashFraction != tc.cash {
+				t.Errorf("Expected %s with fraction %d to get %d/%d got %d/%d", tc.currency.Code, tc.currency.Fraction,
+					tc.accounting, tc.cash, c.AccountingFraction, c.CashFraction)
+			}
+		}
+	}
+
+	if c := NewRegistry(GetCurrency(ALL)).CurrencyByCode(ALL); c.AccountingFraction != 0 || c.CashFraction != 0 {
+		t.Errorf("Expected a copy of ALL to keep its fractions got %d/%d", c.AccountingFraction, c.CashFraction)
+	}
+}
+
+func TestCurrency_Exponent(t *testing.T) {
+	if e := GetCurrency(ISK).Exponent("stripe"); e != 2 {
+		t.Errorf("Expected ISK to have 2 decimals in the stripe scheme got %d", e)
+	}
+
+	if e := GetCurrency(EUR).Exponent("stripe"); e != 2 {
+		t.Errorf("Expected EUR to have its fraction in the stripe scheme got %d", e)
+	}
+
+	abc := (&Currency{Code: "ABC", Fraction: 0}).WithExponent("legacy", 2)
+	r := NewRegistry(abc, abc.WithExponent("visa", 1))
+	c := r.CurrencyByCode("ABC")
+
+	if c.Exponent("legacy") != 2 {
+		t.Errorf("Expected 2 got %d", c.Exponent("legacy"))
+	}
+
+	if _, ok := abc.meta.exponents["visa"]; c.Exponent("visa") != 1 || ok {
+		t.Errorf("Expected 1 for the registered copy and no visa exponent for the original got %d and %v",
+			c.Exponent("visa"), abc.meta.exponents)
+	}
+
+	if c.Exponent("mastercard") != 0 {
+		t.Errorf("Expected the fraction 0 got %d", c.Exponent("mastercard"))
+	}
+
+	a, err := r.New(150, "ABC").Rescale(c.Exponent("legacy"), RoundHalfUp)
+	if err != nil || a != 15000 {
+		t.Errorf("Expected 15000 got %d %v", a, err)
+	}
+
+	if (*Currency)(nil).Exponent("visa") != 2 {
+		t.Errorf("Expected the default fraction for a nil currency got %d", (*Currency)(nil).Exponent("visa"))
+	}
+}
+
+// TestCurrency_Comparable checks that currencies can still be compared and used as map keys.
+func TestCurrency_Comparable(t *testing.T) {
+	a, b := *GetCurrency(EUR), *GetCurrency(EUR)
+	if a != b {
+		t.Errorf("Expected copies of %s to be equal", a.Code)
+	}
+
+	seen := map[Currency]bool{a: true}
+	if !seen[b] || seen[*GetCurrency(USD)] {
+		t.Error("Expected currencies to be usable as map keys")
+	}
 }
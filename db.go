@@ -2,7 +2,9 @@ package money
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -21,36 +23,47 @@ const (
 )
 
 // Value implements driver.Valuer to serialise a Money instance into a delimited string using the DBMoneyValueSeparator
-// for example: "amount|currency_code"
+// for example: "amount|currency_code". The zero value Money is stored as "0|".
 func (m *Money) Value() (driver.Value, error) {
-	return fmt.Sprintf("%d%s%s", m.amount, DBMoneyValueSeparator, m.Currency().Code), nil
+	return fmt.Sprintf("%d%s%s", m.Amount(), DBMoneyValueSeparator, codeOf(m.Currency())), nil
 }
 
 // Scan implements sql.Scanner to deserialize a Money instance from a DBMoneyValueSeparator-separated string
 // for example: "amount|currency_code"
 func (m *Money) Scan(src interface{}) error {
+	if m == nil {
+		return errors.New("can't scan into nil Money")
+	}
+
 	var amount Amount
-	currency := &Currency{}
+	// The currency is looked up in the Registry of the currency of m, if any.
+	currency := &Currency{registry: m.currency.registryOrNil()}
 
 	// let's support string and int64
 	switch src.(type) {
 	case string:
 		parts := strings.Split(src.(string), DBMoneyValueSeparator)
+		// The zero value is stored without a currency, see Value.
+		if len(parts) == 2 && parts[0] == "0" && parts[1] == "" {
+			*m = Money{}
+			return nil
+		}
+
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return fmt.Errorf("%#v is not valid to scan into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src.(string), DBMoneyValueSeparator)
+			return &ParseError{Op: "Scan", Input: src.(string), Err: fmt.Errorf("not valid to scan into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", DBMoneyValueSeparator)}
 		}
 
 		if a, err := strconv.ParseInt(parts[0], 10, 64); err == nil {
 			amount = a
 		} else {
-			return fmt.Errorf("scanning %#v into an Amount: %v", parts[0], err)
+			return &ParseError{Op: "Scan", Input: src.(string), Err: fmt.Errorf("scanning %#v into an Amount: %w", parts[0], errors.Unwrap(err))}
 		}
 
 		if err := currency.Scan(parts[1]); err != nil {
-			return fmt.Errorf("scanning %#v into a Currency: %v", parts[1], err)
+			return &ParseError{Op: "Scan", Input: src.(string), Err: fmt.Errorf("scanning %#v into a Currency: %w", parts[1], errors.Unwrap(err))}
 		}
 	default:
-		return fmt.Errorf("don't know how to scan %T into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)
+		return &ParseError{Op: "Scan", Input: fmt.Sprint(src), Err: fmt.Errorf("don't know how to scan %T into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)}
 	}
 
 	// allocate new Money with the scanned amount and currency
@@ -62,24 +75,79 @@ func (m *Money) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements driver.Valuer to serialise a BigMoney instance into a delimited string using the DBMoneyValueSeparator
+// for example: "amount|currency_code". The zero value BigMoney is stored as "0|".
+func (m *BigMoney) Value() (driver.Value, error) {
+	return fmt.Sprintf("%s%s%s", m.value().String(), DBMoneyValueSeparator, codeOf(m.Currency())), nil
+}
+
+// Scan implements sql.Scanner to deserialize a BigMoney instance from a DBMoneyValueSeparator-separated string
+// for example: "amount|currency_code"
+func (m *BigMoney) Scan(src interface{}) error {
+	if m == nil {
+		return errors.New("can't scan into nil BigMoney")
+	}
+
+	amount := new(big.Int)
+	currency := &Currency{}
+
+	// let's support string only, an int64 column can't hold every amount
+	switch src.(type) {
+	case string:
+		parts := strings.Split(src.(string), DBMoneyValueSeparator)
+		// The zero value is stored without a currency, see Value.
+		if len(parts) == 2 && parts[0] == "0" && parts[1] == "" {
+			*m = BigMoney{}
+			return nil
+		}
+
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return &ParseError{Op: "Scan", Input: src.(string), Err: fmt.Errorf("not valid to scan into BigMoney; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", DBMoneyValueSeparator)}
+		}
+
+		if _, ok := amount.SetString(parts[0], 10); !ok {
+			return &ParseError{Op: "Scan", Input: src.(string), Err: fmt.Errorf("scanning %#v into a big amount: not a base 10 integer", parts[0])}
+		}
+
+		if err := currency.Scan(parts[1]); err != nil {
+			return &ParseError{Op: "Scan", Input: src.(string), Err: fmt.Errorf("scanning %#v into a Currency: %w", parts[1], errors.Unwrap(err))}
+		}
+	default:
+		return &ParseError{Op: "Scan", Input: fmt.Sprint(src), Err: fmt.Errorf("don't know how to scan %T into BigMoney; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)}
+	}
+
+	// allocate new BigMoney with the scanned amount and currency
+	*m = BigMoney{
+		amount:   amount,
+		currency: currency,
+	}
+
+	return nil
+}
+
 // Value implements driver.Valuer to serialize a Currency code into a string for saving to a database
 func (c Currency) Value() (driver.Value, error) {
 	return c.Code, nil
 }
 
-// Scan implements sql.Scanner to deserialize a Currency from a string value read from a database
+// Scan implements sql.Scanner to deserialize a Currency from a string value read from a database.
+// It returns ErrUnknownCurrency for a code which isn't registered, whether or not the default Registry is strict.
 func (c *Currency) Scan(src interface{}) error {
+	if c == nil {
+		return errors.New("can't scan into nil Currency")
+	}
+
 	var val *Currency
 	// let's support string only
 	switch src.(type) {
 	case string:
-		val = GetCurrency(src.(string))
+		val = c.registry.GetCurrency(src.(string))
 	default:
-		return fmt.Errorf("%T is not a supported type for a Currency (store the Currency.Code value as a string only)", src)
+		return &ParseError{Op: "Scan", Input: fmt.Sprint(src), Err: fmt.Errorf("%T is not a supported type for a Currency (store the Currency.Code value as a string only)", src)}
 	}
 
 	if val == nil {
-		return fmt.Errorf("GetCurrency(%#v) returned nil", src)
+		return &ParseError{Op: "Scan", Input: src.(string), Err: ErrUnknownCurrency}
 	}
 
 	// copy the value
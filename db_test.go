@@ -2,6 +2,7 @@ package money
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -24,6 +25,16 @@ func TestMoney_Value(t *testing.T) {
 			separator: "+-+",
 			want:      "-10+-+USD",
 		},
+		{
+			have:      &Money{},
+			separator: "|",
+			want:      "0|",
+		},
+		{
+			have:      nil,
+			separator: "|",
+			want:      "0|",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%#v", tt.have), func(t *testing.T) {
@@ -61,6 +72,10 @@ func TestMoney_Scan(t *testing.T) {
 			separator: ",",
 			want:      New(30000, IDR),
 		},
+		{
+			src:  "0|",
+			want: &Money{},
+		},
 		{
 			src:     "10|",
 			wantErr: true,
@@ -120,6 +135,74 @@ func TestMoney_Scan(t *testing.T) {
 	}
 }
 
+func TestBigMoney_Value(t *testing.T) {
+	DBMoneyValueSeparator = DefaultDBMoneyValueSeparator
+	have, _ := NewBigFromString("-123456789012345678901234567890", USD)
+	want := driver.Value("-123456789012345678901234567890|USD")
+
+	got, err := have.Value()
+	if err != nil {
+		t.Errorf("Value() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Value() got = %v, want %v", got, want)
+	}
+}
+
+func TestBigMoney_Scan(t *testing.T) {
+	tests := []struct {
+		src     interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			src:  "10|CAD",
+			want: "10",
+		},
+		{
+			src:  "-123456789012345678901234567890|USD",
+			want: "-123456789012345678901234567890",
+		},
+		{
+			src:  "0|",
+			want: "0",
+		},
+		{
+			src:     "10|",
+			wantErr: true,
+		},
+		{
+			src:     "1.5|USD",
+			wantErr: true,
+		},
+		{
+			src:     "10|NOTACURRENCY",
+			wantErr: true,
+		},
+		{
+			src:     int64(10),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%#v", tt.src), func(t *testing.T) {
+			DBMoneyValueSeparator = DefaultDBMoneyValueSeparator
+			got := &BigMoney{}
+			if err := got.Scan(tt.src); (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Amount().String() != tt.want {
+				t.Errorf("Scan() got = %s, want %s", got.Amount(), tt.want)
+			}
+		})
+	}
+}
+
 func TestCurrency_Value(t *testing.T) {
 	for code, cc := range currencies {
 		t.Run(code, func(t *testing.T) {
@@ -153,3 +236,19 @@ func TestCurrency_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestCurrency_Scan_Unknown(t *testing.T) {
+	for _, src := range []string{"XYZ", "EURO", ""} {
+		if err := (&Currency{}).Scan(src); !errors.Is(err, ErrUnknownCurrency) {
+			t.Errorf("Expected %v scanning %q got %v", ErrUnknownCurrency, src, err)
+		}
+	}
+
+	if err := (&Money{}).Scan("10|XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+
+	if err := (&BigMoney{}).Scan("10|XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+}
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// parseDecimal parses a plain decimal number such as "7.25", "-0.5" or "1.0834" into an exact rational.
+// Exponents and fractions aren't accepted so the value reads the same as it would on an invoice.
+func parseDecimal(s string) (*big.Rat, error) {
+	digits, dot := 0, false
+	for i, ch := range s {
+		switch {
+		case ch >= '0' && ch <= '9':
+			digits++
+		case ch == '.' && !dot:
+			dot = true
+		case (ch == '-' || ch == '+') && i == 0:
+		default:
+			return nil, fmt.Errorf("%q is not a valid decimal", s)
+		}
+	}
+
+	if digits == 0 {
+		return nil, fmt.Errorf("%q is not a valid decimal", s)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a valid decimal", s)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,83 @@
+package money
+
+import "fmt"
+
+// CurrencyMismatchError happens when an operation is given Money of distinct currencies.
+// It matches ErrCurrencyMismatch with errors.Is.
+type CurrencyMismatchError struct {
+	Op    string // operation that failed, e.g. "Add"
+	Left  string // currency code of Self
+	Right string // currency code of the other Money
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("money: %s: %v (%s != %s)", e.Op, ErrCurrencyMismatch, e.Left, e.Right)
+}
+
+// Unwrap returns ErrCurrencyMismatch.
+func (e *CurrencyMismatchError) Unwrap() error {
+	return ErrCurrencyMismatch
+}
+
+// OverflowError happens when the result of an operation doesn't fit into Amount.
+// It matches ErrOverflow with errors.Is.
+type OverflowError struct {
+	Op       string // operation that failed, e.g. "Multiply", the same for all its variants such as MultiplyRat
+	Currency string // currency code of the Money operated on
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("money: %s: %v (%s)", e.Op, ErrOverflow, e.Currency)
+}
+
+// Unwrap returns ErrOverflow.
+func (e *OverflowError) Unwrap() error {
+	return ErrOverflow
+}
+
+// ParseError happens when Money, BigMoney or a Currency can't be read from JSON, a database value or a string.
+// Errors of UnmarshalJSON match ErrInvalidJSONUnmarshal with errors.Is, whatever their reason,
+// e.g. a JSON syntax error or ErrUnknownCurrency, which they match too.
+type ParseError struct {
+	Op    string // operation that failed, e.g. "UnmarshalJSON" or "Scan"
+	Input string // input that couldn't be parsed
+	Err   error  // reason the input couldn't be parsed
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("money: %s %q: %v", e.Op, e.Input, e.Err)
+}
+
+// Unwrap returns the reason the input couldn't be parsed.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error of UnmarshalJSON is compared with ErrInvalidJSONUnmarshal.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidJSONUnmarshal && e.Op == "UnmarshalJSON"
+}
+
+// mismatch returns a *CurrencyMismatchError for op on Money of currencies l and r.
+func mismatch(op string, l, r *Currency) error {
+	return &CurrencyMismatchError{Op: op, Left: codeOf(l), Right: codeOf(r)}
+}
+
+// overflowed replaces a bare ErrOverflow with an *OverflowError for op on Money of currency c.
+// Other errors are returned unchanged.
+func overflowed(err error, op string, c *Currency) error {
+	if err == ErrOverflow {
+		return &OverflowError{Op: op, Currency: codeOf(c)}
+	}
+
+	return err
+}
+
+// codeOf returns the code of c, or an empty string for the currency of zero value Money.
+func codeOf(c *Currency) string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Code
+}
@@ -0,0 +1,138 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	tcs := []struct {
+		op  string
+		err error
+	}{
+		{"Add", func() error { _, err := New(1, EUR).Add(New(1, USD)); return err }()},
+		{"Subtract", func() error { _, err := New(1, EUR).Subtract(New(1, USD)); return err }()},
+		{"Equals", func() error { _, err := New(1, EUR).Equals(New(1, USD)); return err }()},
+		{"Clamp", func() error { _, err := New(1, EUR).Clamp(New(0, USD), New(2, EUR)); return err }()},
+		{"Max", func() error { _, err := Max(New(1, EUR), New(1, USD)); return err }()},
+		{"Allocate", func() error {
+			_, err := New(1, EUR).AllocateByPlan(AllocationPlan{FixedParty(New(1, USD))})
+			return err
+		}()},
+		{"SortAscending", SortAscending([]*Money{New(1, EUR), New(1, USD)})},
+		{"Add", func() error { _, err := NewBig(nil, EUR).Add(NewBig(nil, USD)); return err }()},
+	}
+
+	for _, tc := range tcs {
+		if !errors.Is(tc.err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %v to match %v", tc.err, ErrCurrencyMismatch)
+		}
+
+		var e *CurrencyMismatchError
+		if !errors.As(tc.err, &e) {
+			t.Errorf("Expected %v to be a *CurrencyMismatchError", tc.err)
+			continue
+		}
+
+		if e.Op != tc.op || e.Left != EUR || e.Right != USD {
+			t.Errorf("Expected %s EUR != USD got %s %s != %s", tc.op, e.Op, e.Left, e.Right)
+		}
+	}
+
+	_, err := New(1, EUR).Add(New(1, USD))
+	if err.Error() != "money: Add: currencies don't match (EUR != USD)" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+func TestOverflowError(t *testing.T) {
+	tcs := []struct {
+		op  string
+		err error
+	}{
+		{"Add", func() error { _, err := New(math.MaxInt64, EUR).Add(New(1, EUR)); return err }()},
+		{"Subtract", func() error { _, err := New(math.MinInt64, EUR).Subtract(New(1, EUR)); return err }()},
+		{"Multiply", func() error { _, err := New(math.MaxInt64, EUR).MultiplyE(2); return err }()},
+		{"Multiply", func() error { _, err := New(math.MaxInt64, EUR).MultiplyDecimal("2", RoundHalfUp); return err }()},
+		{"Round", func() error { _, err := New(math.MaxInt64, EUR).RoundWithMode(RoundUp); return err }()},
+		{"Allocate", func() error {
+			_, err := New(math.MaxInt64, EUR).AllocateByPlan(AllocationPlan{FixedParty(New(-1, EUR)), RatioParty(1)})
+			return err
+		}()},
+		{"CopySign", func() error { _, err := New(math.MinInt64, EUR).CopySign(New(1, EUR)); return err }()},
+		{"Divide", func() error { _, err := New(math.MinInt64, EUR).Divide(-1, RoundHalfUp); return err }()},
+		{"Money", func() error { _, err := New(math.MaxInt64, EUR).Big().Multiply(2).Money(); return err }()},
+	}
+
+	for _, tc := range tcs {
+		if !errors.Is(tc.err, ErrOverflow) {
+			t.Errorf("Expected %v to match %v", tc.err, ErrOverflow)
+		}
+
+		var e *OverflowError
+		if !errors.As(tc.err, &e) {
+			t.Errorf("Expected %v to be an *OverflowError", tc.err)
+			continue
+		}
+
+		if e.Op != tc.op || e.Currency != EUR {
+			t.Errorf("Expected %s EUR got %s %s", tc.op, e.Op, e.Currency)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	var m Money
+	err := json.Unmarshal([]byte(`{"amount": "foo", "currency": "USD"}`), &m)
+	if !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	var e *ParseError
+	if !errors.As(err, &e) || e.Op != "UnmarshalJSON" || e.Input != `{"amount": "foo", "currency": "USD"}` {
+		t.Errorf("Expected *ParseError of UnmarshalJSON got %#v", err)
+	}
+
+	// Other tests replace the JSON injection points, so the default is called directly.
+	if err := defaultUnmarshalJSON(&Money{}, []byte(`{"amount": 1`)); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected a syntax error to match %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	unknown := &ParseError{Op: "UnmarshalJSON", Input: "XYZ", Err: ErrUnknownCurrency}
+	if !errors.Is(unknown, ErrInvalidJSONUnmarshal) || !errors.Is(unknown, ErrUnknownCurrency) {
+		t.Errorf("Expected %v to match both %v and %v", unknown, ErrInvalidJSONUnmarshal, ErrUnknownCurrency)
+	}
+
+	if _, err := Parse("x", EUR); errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected an error of Parse not to match %v", ErrInvalidJSONUnmarshal)
+	}
+
+	var bm BigMoney
+	err = json.Unmarshal([]byte(`{"amount": "1.5", "currency": "USD"}`), &bm)
+	if !errors.Is(err, ErrInvalidJSONUnmarshal) || !errors.As(err, &e) {
+		t.Errorf("Expected *ParseError matching %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	tcs := []struct {
+		src   interface{}
+		input string
+	}{
+		{"10", "10"},
+		{"foo|EUR", "foo|EUR"},
+		{"10|XYZ", "10|XYZ"},
+		{int64(10), "10"},
+	}
+
+	for _, tc := range tcs {
+		err := (&Money{}).Scan(tc.src)
+		if !errors.As(err, &e) || e.Op != "Scan" || e.Input != tc.input {
+			t.Errorf("Expected *ParseError of Scan for %q got %#v", tc.input, err)
+		}
+	}
+
+	if _, err := NewBigFromString("1e3", EUR); !errors.As(err, &e) || e.Input != "1e3" {
+		t.Errorf("Expected *ParseError for 1e3 got %#v", err)
+	}
+}
@@ -2,6 +2,7 @@ package money
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -26,37 +27,82 @@ func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *F
 	}
 }
 
+// orDefault returns f, or the formatter of the default currency if f is nil.
+func (f *Formatter) orDefault() *Formatter {
+	if f == nil {
+		return (*Currency)(nil).Formatter()
+	}
+
+	return f
+}
+
 // Format returns string of formatted integer using given currency template.
 func (f *Formatter) Format(amount int64) string {
+	f = f.orDefault()
+
 	// Work with absolute amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+	return f.format(strconv.FormatUint(f.abs(amount), 10), amount < 0)
+}
 
-	if len(sa) <= f.Fraction {
-		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
+// FormatBig returns string of formatted big integer using given currency template.
+// A nil amount is formatted as zero.
+func (f *Formatter) FormatBig(amount *big.Int) string {
+	if amount == nil {
+		amount = new(big.Int)
+	}
+
+	return f.format(new(big.Int).Abs(amount).String(), amount.Sign() < 0)
+}
+
+// fraction returns Fraction bounded to 0 and MaxFraction. The currencies of a Registry are always
+// in range, so formatting them is exact, the bounds only keep other formatters from exhausting memory.
+func (f *Formatter) fraction() int {
+	switch {
+	case f.Fraction < 0:
+		return 0
+	case f.Fraction > MaxFraction:
+		return MaxFraction
+	}
+
+	return f.Fraction
+}
+
+// format returns string of formatted absolute amount digits using given currency template.
+// A negative Fraction is treated as zero, one above MaxFraction as MaxFraction.
+func (f *Formatter) format(sa string, negative bool) string {
+	f = f.orDefault()
+
+	fraction := f.fraction()
+
+	if len(sa) <= fraction {
+		sa = strings.Repeat("0", fraction-len(sa)+1) + sa
 	}
 
 	if f.Thousand != "" {
-		for i := len(sa) - f.Fraction - 3; i > 0; i -= 3 {
+		for i := len(sa) - fraction - 3; i > 0; i -= 3 {
 			sa = sa[:i] + f.Thousand + sa[i:]
 		}
 	}
 
-	if f.Fraction > 0 {
-		sa = sa[:len(sa)-f.Fraction] + f.Decimal + sa[len(sa)-f.Fraction:]
+	if fraction > 0 {
+		sa = sa[:len(sa)-fraction] + f.Decimal + sa[len(sa)-fraction:]
 	}
 	sa = strings.Replace(f.Template, "1", sa, 1)
 	sa = strings.Replace(sa, "$", f.Grapheme, 1)
 
 	// Add minus sign for negative amount.
-	if amount < 0 {
+	if negative {
 		sa = "-" + sa
 	}
 
 	return sa
 }
 
-// ToMajorUnits returns float64 representing the value in sub units using the currency data
+// ToMajorUnits returns float64 representing the value in sub units using the currency data.
+// The result is rounded to float64, use ToMajorUnitsRat for an exact value.
 func (f *Formatter) ToMajorUnits(amount int64) float64 {
+	f = f.orDefault()
+
 	if f.Fraction == 0 {
 		return float64(amount)
 	}
@@ -64,11 +110,40 @@ func (f *Formatter) ToMajorUnits(amount int64) float64 {
 	return float64(amount) / float64(math.Pow10(f.Fraction))
 }
 
-// abs return absolute value of given integer.
-func (f Formatter) abs(amount int64) int64 {
+// ToMajorUnitsBig returns float64 representing the big value in sub units using the currency data.
+func (f *Formatter) ToMajorUnitsBig(amount *big.Int) float64 {
+	f = f.orDefault()
+
+	if amount == nil {
+		return 0
+	}
+
+	v, _ := f.ToMajorUnitsRat(amount).Float64()
+	return v
+}
+
+// ToMajorUnitsRat returns the exact value of the big amount of subunits in major units.
+// A nil amount is zero.
+func (f *Formatter) ToMajorUnitsRat(amount *big.Int) *big.Rat {
+	f = f.orDefault()
+
+	r := new(big.Rat)
+	if amount == nil {
+		return r
+	}
+
+	r.SetInt(amount)
+	p, _ := pow10Big(f.fraction())
+	r.Quo(r, new(big.Rat).SetInt(p))
+
+	return r
+}
+
+// abs return absolute value of given integer, which unlike int64 also holds |math.MinInt64|.
+func (f Formatter) abs(amount int64) uint64 {
 	if amount < 0 {
-		return -amount
+		return uint64(-amount)
 	}
 
-	return amount
+	return uint64(amount)
 }
@@ -1,6 +1,10 @@
 package money
 
 import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -86,6 +90,94 @@ func TestFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestFormatter_FormatBig(t *testing.T) {
+	tcs := []struct {
+		fraction int
+		thousand string
+		amount   string
+		expected string
+	}{
+		{2, ",", "0", "0.00 $"},
+		{2, ",", "-1", "-0.01 $"},
+		{2, ",", "123456789", "1,234,567.89 $"},
+		{2, ",", "123456789012345678901234", "1,234,567,890,123,456,789,012.34 $"},
+		{18, ",", "1", "0.000000000000000001 $"},
+		{18, ",", "1500000000000000000", "1.500000000000000000 $"},
+		{18, ",", "-123456789000000000000000", "-123,456.789000000000000000 $"},
+		{0, "", "99999999999999999999", "99999999999999999999 $"},
+	}
+
+	for _, tc := range tcs {
+		amount, _ := new(big.Int).SetString(tc.amount, 10)
+		formatter := NewFormatter(tc.fraction, ".", tc.thousand, "$", "1 $")
+		r := formatter.FormatBig(amount)
+
+		if r != tc.expected {
+			t.Errorf("Expected %s formatted to be %s got %s", tc.amount, tc.expected, r)
+		}
+	}
+}
+
+func TestFormatter_FormatMinInt64(t *testing.T) {
+	formatter := NewFormatter(2, ".", ",", "$", "1 $")
+	expected := "-92,233,720,368,547,758.08 $"
+
+	if r := formatter.Format(math.MinInt64); r != expected {
+		t.Errorf("Expected %d formatted to be %s got %s", int64(math.MinInt64), expected, r)
+	}
+}
+
+func TestFormatter_MaxFraction(t *testing.T) {
+	expected := "$0." + strings.Repeat("0", MaxFraction-1) + "1"
+	if r := NewFormatter(MaxFraction, ".", ",", "$", "$1").Format(1); r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+
+	if f := NewFormatter(MaxFraction+1, ".", ",", "$", "$1"); f.Fraction != MaxFraction+1 {
+		t.Errorf("Expected the fraction %d to be kept got %d", MaxFraction+1, f.Fraction)
+	}
+
+	// Fractions which would format amounts off by orders of magnitude can't be registered.
+	for _, c := range []*Currency{
+		{Code: "HUGE", Fraction: MaxFraction + 1},
+		{Code: "NEG", Fraction: -1},
+		{Code: "CASH", Fraction: 2, CashFraction: -1},
+		(&Currency{Code: "UNIT", Fraction: 2}).WithUnits(Unit{Name: "u", Exponent: math.MaxInt}),
+		(&Currency{Code: "SCHEME", Fraction: 2}).WithExponent("visa", MaxFraction+1),
+	} {
+		r := NewRegistry(c)
+		if r.CurrencyByCode(c.Code) != nil {
+			t.Errorf("Expected %s not to be added", c.Code)
+		}
+
+		if err := r.Register(c); !errors.Is(err, ErrInvalidFraction) {
+			t.Errorf("Expected ErrInvalidFraction registering %s got %v", c.Code, err)
+		}
+
+		if ac := r.AddCurrency(c.Code, "$", "$1", ".", ",", c.Fraction); c.Fraction == 2 && ac == nil {
+			t.Errorf("Expected %s with fraction 2 to be added", c.Code)
+		} else if c.Fraction != 2 && (ac != nil || r.CurrencyByCode(c.Code) != nil) {
+			t.Errorf("Expected %s with fraction %d not to be added got %v", c.Code, c.Fraction, ac)
+		}
+	}
+
+	if err := NewRegistry().Register(&Currency{Code: "MAX", Fraction: MaxFraction}); err != nil {
+		t.Errorf("Expected fraction %d to be registered got %v", MaxFraction, err)
+	}
+}
+
+func TestPow10Big(t *testing.T) {
+	for _, e := range []int{-1, MaxFraction + 1, math.MaxInt} {
+		if _, err := pow10Big(e); !errors.Is(err, ErrInvalidFraction) {
+			t.Errorf("Expected ErrInvalidFraction for 10^%d got %v", e, err)
+		}
+	}
+
+	if p, err := pow10Big(MaxFraction); err != nil || len(p.String()) != MaxFraction+1 {
+		t.Errorf("Expected 10^%d got %v %v", MaxFraction, p, err)
+	}
+}
+
 func TestFormatter_ToMajorUnits(t *testing.T) {
 	tcs := []struct {
 		fraction int
@@ -167,3 +259,27 @@ func TestFormatter_ToMajorUnits(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatter_ToMajorUnitsBig(t *testing.T) {
+	tcs := []struct {
+		fraction int
+		amount   string
+		expected float64
+	}{
+		{2, "123456789", 1234567.89},
+		{2, "-123", -1.23},
+		{0, "12345", 12345},
+		{18, "1500000000000000000", 1.5},
+		{18, "123456789000000000000000", 123456.789},
+	}
+
+	for _, tc := range tcs {
+		amount, _ := new(big.Int).SetString(tc.amount, 10)
+		formatter := NewFormatter(tc.fraction, ".", ",", "$", "1 $")
+		r := formatter.ToMajorUnitsBig(amount)
+
+		if r != tc.expected {
+			t.Errorf("Expected %s formatted to major units to be %f got %f", tc.amount, tc.expected, r)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+//go:build go1.18
+// +build go1.18
+
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func FuzzMoney(f *testing.F) {
+	f.Add(int64(100), int64(3), EUR, EUR, int64(2), 3, 0, "1.5")
+	f.Add(int64(math.MaxInt64), int64(-1), USD, EUR, int64(-1), 0, 6, "-0.05")
+	f.Add(int64(math.MinInt64), int64(math.MinInt64), JPY, JPY, int64(math.MinInt64), -1, 7, "10|JPY")
+	f.Add(int64(0), int64(0), "", "XYZ", int64(0), 64, -1, `{"amount": 1, "currency": "EUR"}`)
+
+	f.Fuzz(func(t *testing.T, a, b int64, code, ocode string, i int64, n, mode int, s string) {
+		m, om := New(a, code), New(b, ocode)
+		exerciseMoney(m, om, i, n, RoundingMode(mode), s)
+		exerciseMoney(om, nil, i, n, RoundingMode(mode), s)
+		exerciseMoney(nil, m, i, n, RoundingMode(mode), s)
+
+		n %= maxParties
+		ms, err := m.Split(n)
+		if n < 1 {
+			if err == nil {
+				t.Fatalf("Expected error splitting %d in %d", a, n)
+			}
+
+			return
+		}
+
+		if err != nil {
+			t.Fatalf("Unexpected error splitting %d in %d: %v", a, n, err)
+		}
+
+		sum, err := Sum(ms...)
+		if err != nil || sum.amount != a {
+			t.Fatalf("Expected %d split in %d to add up got %v, %v", a, n, sum, err)
+		}
+	})
+}
+
+func FuzzBigMoney(f *testing.F) {
+	f.Add("100", "-3", EUR, int64(2), 3, 0, "1.5")
+	f.Add("-123456789012345678901234567890", "1", USD, int64(math.MinInt64), 0, 6, "-0.05")
+	f.Add("0", "0", "", int64(0), 64, -1, `{"amount": "1", "currency": "EUR"}`)
+
+	f.Fuzz(func(t *testing.T, a, b, code string, i int64, n, mode int, s string) {
+		m, err := NewBigFromString(a, code)
+		if err != nil {
+			m = nil
+		}
+
+		om, err := NewBigFromString(b, code)
+		if err != nil {
+			om = NewBig(nil, code)
+		}
+
+		exerciseBigMoney(m, om, i, n, RoundingMode(mode), s)
+		exerciseBigMoney(om, nil, i, n, RoundingMode(mode), s)
+	})
+}
+
+func FuzzFormatter(f *testing.F) {
+	f.Add(int64(123456), 2, ".", ",", "€", "1 $")
+	f.Add(int64(math.MinInt64), -3, "", "", "", "")
+	f.Add(int64(1), 30, ",", ".", "$", "$1")
+
+	f.Fuzz(func(t *testing.T, a int64, fraction int, decimal, thousand, grapheme, template string) {
+		// Keep the fraction plausible, a huge one only asks for a huge string.
+		fraction %= 64
+
+		fm := NewFormatter(fraction, decimal, thousand, grapheme, template)
+		fm.Format(a)
+		fm.FormatBig(new(big.Int).Mul(big.NewInt(a), big.NewInt(a)))
+		fm.ToMajorUnits(a)
+		fm.ToMajorUnitsBig(big.NewInt(a))
+	})
+}
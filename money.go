@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 )
 
 // Injection points for backward compatibility.
@@ -24,20 +25,50 @@ var (
 
 	// ErrInvalidJSONUnmarshal happens when the default money.UnmarshalJSON fails to unmarshal Money because of invalid data.
 	ErrInvalidJSONUnmarshal = errors.New("invalid json unmarshal")
+
+	// ErrOverflow happens when the result of an arithmetic operation doesn't fit into Amount.
+	ErrOverflow = errors.New("amount overflows int64")
+
+	// ErrDivisionByZero happens when Money is divided by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrInvalidRoundingMode happens when an operation is given an unknown RoundingMode.
+	ErrInvalidRoundingMode = errors.New("invalid rounding mode")
+
+	// ErrNoMoney happens when an aggregate function such as Sum or Average is given no Money.
+	ErrNoMoney = errors.New("no money given")
+
+	// ErrUnknownCurrency happens when a strict operation is given a currency code which isn't registered.
+	ErrUnknownCurrency = errors.New("unknown currency")
+
+	// ErrWithdrawnCurrency happens when a strict operation creates Money in a currency which is no longer in use.
+	ErrWithdrawnCurrency = errors.New("currency is withdrawn")
+
+	// ErrInvalidFraction happens when a fraction or exponent is negative or above MaxFraction.
+	ErrInvalidFraction = errors.New("fraction is out of range")
+
+	// ErrDuplicateNumericCode happens when a currency is registered with the numeric code of another currency.
+	ErrDuplicateNumericCode = errors.New("numeric code is already used")
 )
 
+// defaultUnmarshalJSON unmarshals into m using the Registry the currency of m belongs to,
+// the default Registry for the zero value Money.
 func defaultUnmarshalJSON(m *Money, b []byte) error {
+	return unmarshalJSON(m.currency.registryOrNil(), m, b)
+}
+
+func unmarshalJSON(r *Registry, m *Money, b []byte) error {
 	data := make(map[string]interface{})
 	err := json.Unmarshal(b, &data)
 	if err != nil {
-		return err
+		return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: err}
 	}
 
 	var amount float64
 	if amountRaw, ok := data["amount"]; ok {
 		amount, ok = amountRaw.(float64)
 		if !ok {
-			return ErrInvalidJSONUnmarshal
+			return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: ErrInvalidJSONUnmarshal}
 		}
 	}
 
@@ -45,15 +76,17 @@ func defaultUnmarshalJSON(m *Money, b []byte) error {
 	if currencyRaw, ok := data["currency"]; ok {
 		currency, ok = currencyRaw.(string)
 		if !ok {
-			return ErrInvalidJSONUnmarshal
+			return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: ErrInvalidJSONUnmarshal}
 		}
 	}
 
 	var ref *Money
 	if amount == 0 && currency == "" {
 		ref = &Money{}
+	} else if err := r.assertKnown(currency); err != nil {
+		return &ParseError{Op: "UnmarshalJSON", Input: string(b), Err: err}
 	} else {
-		ref = New(int64(amount), currency)
+		ref = r.New(int64(amount), currency)
 	}
 
 	*m = *ref
@@ -74,11 +107,24 @@ type Amount = int64
 
 // Money represents monetary value information, stores
 // currency and amount value.
+//
+// The zero value Money{}, as well as a nil *Money, is a zero amount without a currency.
+// Add and Subtract treat it as zero in the currency of the other Money, other operations
+// keep it without a currency, so comparing it with Money of a currency returns ErrCurrencyMismatch.
 type Money struct {
 	amount   Amount    `db:"amount"`
 	currency *Currency `db:"currency"`
 }
 
+// orZero returns m, or the zero value Money if m is nil, so methods can be called on a nil *Money.
+func (m *Money) orZero() *Money {
+	if m == nil {
+		return &Money{}
+	}
+
+	return m
+}
+
 // New creates and returns new instance of Money.
 func New(amount int64, code string) *Money {
 	return &Money{
@@ -87,6 +133,43 @@ func New(amount int64, code string) *Money {
 	}
 }
 
+// NewStrict creates and returns new instance of Money.
+// Unlike New it returns ErrUnknownCurrency when the currency code isn't registered,
+// and ErrWithdrawnCurrency when the currency is no longer in use.
+func NewStrict(amount int64, code string) (*Money, error) {
+	return defaultRegistry.NewStrict(amount, code)
+}
+
+// Parse creates and returns new instance of Money from a decimal amount in major units,
+// e.g. Parse("12.34", EUR). It returns ErrUnknownCurrency when the currency code isn't registered,
+// ErrWithdrawnCurrency when the currency is no longer in use, and an error when the amount has
+// more decimals than the currency.
+func Parse(amount, code string) (*Money, error) {
+	return defaultRegistry.Parse(amount, code)
+}
+
+// NewFromNumericCode creates and returns new instance of Money given the ISO 4217 numeric code
+// of its currency, e.g. NewFromNumericCode(100, "978") for €1.00.
+// It returns ErrUnknownCurrency when no currency has the numeric code, and ErrWithdrawnCurrency
+// when the currency is no longer in use.
+func NewFromNumericCode(amount int64, numericCode string) (*Money, error) {
+	return defaultRegistry.NewFromNumericCode(amount, numericCode)
+}
+
+// NewWithFraction creates and returns new instance of Money from an amount in subunits of the given fraction,
+// such as the exponent of a payment scheme, rounded to the fraction of the currency using the given mode.
+// It returns ErrOverflow when the amount doesn't fit into int64 once rescaled.
+func NewWithFraction(amount int64, fraction int, code string, mode RoundingMode) (*Money, error) {
+	c := newCurrency(code).get()
+
+	a, err := rescale(amount, fraction, c.Fraction, mode)
+	if err != nil {
+		return nil, overflowed(err, "NewWithFraction", c)
+	}
+
+	return &Money{amount: a, currency: c}, nil
+}
+
 // NewFromFloat creates and returns new instance of Money from a float64.
 // Always rounding trailing decimals down.
 func NewFromFloat(amount float64, code string) *Money {
@@ -96,28 +179,38 @@ func NewFromFloat(amount float64, code string) *Money {
 
 // Currency returns the currency used by Money.
 func (m *Money) Currency() *Currency {
+	if m == nil {
+		return nil
+	}
+
 	return m.currency
 }
 
 // Amount returns a copy of the internal monetary value as an int64.
 func (m *Money) Amount() int64 {
+	if m == nil {
+		return 0
+	}
+
 	return m.amount
 }
 
 // SameCurrency check if given Money is equals by currency.
 func (m *Money) SameCurrency(om *Money) bool {
-	return m.currency.equals(om.currency)
+	return m.Currency().equals(om.Currency())
 }
 
-func (m *Money) assertSameCurrency(om *Money) error {
+func (m *Money) assertSameCurrency(om *Money, op string) error {
 	if !m.SameCurrency(om) {
-		return ErrCurrencyMismatch
+		return mismatch(op, m.Currency(), om.Currency())
 	}
 
 	return nil
 }
 
 func (m *Money) compare(om *Money) int {
+	m, om = m.orZero(), om.orZero()
+
 	switch {
 	case m.amount > om.amount:
 		return 1
@@ -130,7 +223,7 @@ func (m *Money) compare(om *Money) int {
 
 // Equals checks equality between two Money types.
 func (m *Money) Equals(om *Money) (bool, error) {
-	if err := m.assertSameCurrency(om); err != nil {
+	if err := m.assertSameCurrency(om, "Equals"); err != nil {
 		return false, err
 	}
 
@@ -139,7 +232,7 @@ func (m *Money) Equals(om *Money) (bool, error) {
 
 // GreaterThan checks whether the value of Money is greater than the other.
 func (m *Money) GreaterThan(om *Money) (bool, error) {
-	if err := m.assertSameCurrency(om); err != nil {
+	if err := m.assertSameCurrency(om, "GreaterThan"); err != nil {
 		return false, err
 	}
 
@@ -148,7 +241,7 @@ func (m *Money) GreaterThan(om *Money) (bool, error) {
 
 // GreaterThanOrEqual checks whether the value of Money is greater or equal than the other.
 func (m *Money) GreaterThanOrEqual(om *Money) (bool, error) {
-	if err := m.assertSameCurrency(om); err != nil {
+	if err := m.assertSameCurrency(om, "GreaterThanOrEqual"); err != nil {
 		return false, err
 	}
 
@@ -157,7 +250,7 @@ func (m *Money) GreaterThanOrEqual(om *Money) (bool, error) {
 
 // LessThan checks whether the value of Money is less than the other.
 func (m *Money) LessThan(om *Money) (bool, error) {
-	if err := m.assertSameCurrency(om); err != nil {
+	if err := m.assertSameCurrency(om, "LessThan"); err != nil {
 		return false, err
 	}
 
@@ -166,7 +259,7 @@ func (m *Money) LessThan(om *Money) (bool, error) {
 
 // LessThanOrEqual checks whether the value of Money is less or equal than the other.
 func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
-	if err := m.assertSameCurrency(om); err != nil {
+	if err := m.assertSameCurrency(om, "LessThanOrEqual"); err != nil {
 		return false, err
 	}
 
@@ -175,113 +268,438 @@ func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
 
 // IsZero returns boolean of whether the value of Money is equals to zero.
 func (m *Money) IsZero() bool {
-	return m.amount == 0
+	return m.Amount() == 0
 }
 
 // IsPositive returns boolean of whether the value of Money is positive.
 func (m *Money) IsPositive() bool {
-	return m.amount > 0
+	return m.Amount() > 0
 }
 
 // IsNegative returns boolean of whether the value of Money is negative.
 func (m *Money) IsNegative() bool {
-	return m.amount < 0
+	return m.Amount() < 0
 }
 
 // Absolute returns new Money struct from given Money using absolute monetary value.
 func (m *Money) Absolute() *Money {
-	return &Money{amount: mutate.calc.absolute(m.amount), currency: m.currency}
+	return &Money{amount: mutate.calc.absolute(m.Amount()), currency: m.Currency()}
 }
 
 // Negative returns new Money struct from given Money using negative monetary value.
 func (m *Money) Negative() *Money {
-	return &Money{amount: mutate.calc.negative(m.amount), currency: m.currency}
+	return &Money{amount: mutate.calc.negative(m.Amount()), currency: m.Currency()}
+}
+
+// Sign returns -1, 0 or 1 depending on whether the value of Money is negative, zero or positive.
+func (m *Money) Sign() int {
+	return m.compare(&Money{amount: 0})
+}
+
+// CopySign returns new Money struct with the absolute monetary value of Self and the sign of the other Money.
+// A zero sign Money is treated as positive.
+func (m *Money) CopySign(om *Money) (*Money, error) {
+	m = m.orZero()
+
+	if err := m.assertSameCurrency(om, "CopySign"); err != nil {
+		return nil, err
+	}
+
+	if om.IsNegative() {
+		return m.Negative(), nil
+	}
+
+	if m.amount == math.MinInt64 {
+		return nil, overflowed(ErrOverflow, "CopySign", m.currency)
+	}
+
+	return m.Absolute(), nil
+}
+
+// Min returns new Money struct with the smaller value of Self and the other Money.
+func (m *Money) Min(om *Money) (*Money, error) {
+	m = m.orZero()
+
+	if err := m.assertSameCurrency(om, "Min"); err != nil {
+		return nil, err
+	}
+
+	if om.compare(m) < 0 {
+		return &Money{amount: om.Amount(), currency: om.Currency()}, nil
+	}
+
+	return &Money{amount: m.amount, currency: m.currency}, nil
+}
+
+// Max returns new Money struct with the larger value of Self and the other Money.
+func (m *Money) Max(om *Money) (*Money, error) {
+	m = m.orZero()
+
+	if err := m.assertSameCurrency(om, "Max"); err != nil {
+		return nil, err
+	}
+
+	if om.compare(m) > 0 {
+		return &Money{amount: om.Amount(), currency: om.Currency()}, nil
+	}
+
+	return &Money{amount: m.amount, currency: m.currency}, nil
+}
+
+// Clamp returns new Money struct with value of Self limited to the range between lo and hi inclusive,
+// e.g. to apply a floor and a cap to a fee.
+func (m *Money) Clamp(lo, hi *Money) (*Money, error) {
+	m = m.orZero()
+
+	if err := m.assertBounds(lo, hi, "Clamp"); err != nil {
+		return nil, err
+	}
+
+	lo, hi = lo.orZero(), hi.orZero()
+
+	switch {
+	case m.compare(lo) < 0:
+		return &Money{amount: lo.amount, currency: lo.currency}, nil
+	case m.compare(hi) > 0:
+		return &Money{amount: hi.amount, currency: hi.currency}, nil
+	}
+
+	return &Money{amount: m.amount, currency: m.currency}, nil
+}
+
+// IsBetween checks whether the value of Money lies between lo and hi inclusive.
+func (m *Money) IsBetween(lo, hi *Money) (bool, error) {
+	if err := m.assertBounds(lo, hi, "IsBetween"); err != nil {
+		return false, err
+	}
+
+	return m.compare(lo) >= 0 && m.compare(hi) <= 0, nil
+}
+
+func (m *Money) assertBounds(lo, hi *Money, op string) error {
+	if err := m.assertSameCurrency(lo, op); err != nil {
+		return err
+	}
+
+	if err := m.assertSameCurrency(hi, op); err != nil {
+		return err
+	}
+
+	if lo.compare(hi) > 0 {
+		return errors.New("lower bound is greater than upper bound")
+	}
+
+	return nil
+}
+
+// commonCurrency returns the currency shared by Self and ms. The zero value Money has no currency
+// and goes with Money of any currency, so the result has no currency only if all of them are zero values.
+func (m *Money) commonCurrency(ms []*Money, op string) (*Currency, error) {
+	base := m
+	for _, m2 := range ms {
+		switch {
+		case m2.Currency() == nil:
+			continue
+		case base.Currency() == nil:
+			base = m2
+		default:
+			if err := base.assertSameCurrency(m2, op); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return base.Currency(), nil
 }
 
 // Add returns new Money struct with value representing sum of Self and Other Money.
+// The zero value Money adopts the currency of the other Money.
 func (m *Money) Add(ms ...*Money) (*Money, error) {
+	m = m.orZero()
+
 	if len(ms) == 0 {
 		return m, nil
 	}
 
-	k := New(0, m.currency.Code)
+	c, err := m.commonCurrency(ms, "Add")
+	if err != nil {
+		return nil, err
+	}
 
-	for _, m2 := range ms {
-		if err := m.assertSameCurrency(m2); err != nil {
-			return nil, err
-		}
+	as := make([]Amount, len(ms))
+	for i, m2 := range ms {
+		as[i] = m2.Amount()
+	}
 
-		k.amount = mutate.calc.add(k.amount, m2.amount)
+	a, err := mutate.calc.sum(m.amount, as, false)
+	if err != nil {
+		return nil, overflowed(err, "Add", c)
 	}
 
-	return &Money{amount: mutate.calc.add(m.amount, k.amount), currency: m.currency}, nil
+	return &Money{amount: a, currency: c}, nil
 }
 
 // Subtract returns new Money struct with value representing difference of Self and Other Money.
+// The zero value Money adopts the currency of the other Money.
 func (m *Money) Subtract(ms ...*Money) (*Money, error) {
+	m = m.orZero()
+
 	if len(ms) == 0 {
 		return m, nil
 	}
 
-	k := New(0, m.currency.Code)
+	c, err := m.commonCurrency(ms, "Subtract")
+	if err != nil {
+		return nil, err
+	}
 
-	for _, m2 := range ms {
-		if err := m.assertSameCurrency(m2); err != nil {
-			return nil, err
-		}
+	as := make([]Amount, len(ms))
+	for i, m2 := range ms {
+		as[i] = m2.Amount()
+	}
 
-		k.amount = mutate.calc.add(k.amount, m2.amount)
+	a, err := mutate.calc.sum(m.amount, as, true)
+	if err != nil {
+		return nil, overflowed(err, "Subtract", c)
 	}
 
-	return &Money{amount: mutate.calc.subtract(m.amount, k.amount), currency: m.currency}, nil
+	return &Money{amount: a, currency: c}, nil
 }
 
 // Multiply returns new Money struct with value representing Self multiplied value by multiplier.
+// The result wraps around if it doesn't fit into Amount, use MultiplyE to detect that.
+// Without multipliers a copy of Self is returned.
 func (m *Money) Multiply(muls ...int64) *Money {
+	m = m.orZero()
+	k := &Money{amount: m.amount, currency: m.currency}
+
+	for _, m2 := range muls {
+		k.amount, _ = mutate.calc.multiply(k.amount, m2)
+	}
+
+	return k
+}
+
+// MultiplyE returns new Money struct with value representing Self multiplied value by multiplier.
+// Unlike Multiply it returns ErrOverflow when the result doesn't fit into Amount.
+func (m *Money) MultiplyE(muls ...int64) (*Money, error) {
+	m = m.orZero()
+
 	if len(muls) == 0 {
-		panic("At least one multiplier is required to multiply")
+		return nil, errors.New("at least one multiplier is required to multiply")
+	}
+
+	for _, m2 := range muls {
+		// A zero multiplier makes the product zero whatever the other factors are.
+		if m2 == 0 {
+			return &Money{amount: 0, currency: m.currency}, nil
+		}
 	}
 
-	k := New(1, m.currency.Code)
+	k := &Money{amount: m.amount, currency: m.currency}
 
 	for _, m2 := range muls {
-		k.amount = mutate.calc.multiply(k.amount, m2)
+		a, err := mutate.calc.multiply(k.amount, m2)
+		if err != nil {
+			return nil, overflowed(err, "Multiply", m.currency)
+		}
+
+		k.amount = a
+	}
+
+	return k, nil
+}
+
+// MultiplyRat returns new Money struct with value representing Self multiplied by the rational factor r.
+// The product is computed exactly and rounded once using the given mode.
+func (m *Money) MultiplyRat(r *big.Rat, mode RoundingMode) (*Money, error) {
+	m = m.orZero()
+
+	if r == nil {
+		return nil, errors.New("factor is required to multiply")
+	}
+
+	a, err := mutate.calc.multiplyRat(m.amount, r, mode)
+	if err != nil {
+		return nil, overflowed(err, "Multiply", m.currency)
 	}
 
-	return &Money{amount: mutate.calc.multiply(m.amount, k.amount), currency: m.currency}
+	return &Money{amount: a, currency: m.currency}, nil
 }
 
-// Round returns new Money struct with value rounded to nearest zero.
+// MultiplyDecimal returns new Money struct with value representing Self multiplied by the decimal factor d,
+// e.g. "1.0834". The product is computed exactly and rounded once using the given mode.
+func (m *Money) MultiplyDecimal(d string, mode RoundingMode) (*Money, error) {
+	r, err := parseDecimal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MultiplyRat(r, mode)
+}
+
+// Percent returns new Money struct with value representing p percent of Self, where p is a decimal
+// such as "7.25". The result is computed exactly and rounded once using the given mode.
+func (m *Money) Percent(p string, mode RoundingMode) (*Money, error) {
+	r, err := parseDecimal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MultiplyRat(r.Quo(r, big.NewRat(100, 1)), mode)
+}
+
+// Round returns new Money struct with value rounded to the nearest whole major unit, ties are rounded toward zero.
+// It is the same as RoundWithMode(RoundHalfDown) except that the result wraps around on overflow.
+// Round keeps the signature it has always had, BigMoney and Typed follow it, v2 takes the mode instead.
 func (m *Money) Round() *Money {
-	return &Money{amount: mutate.calc.round(m.amount, m.currency.Fraction), currency: m.currency}
+	m = m.orZero()
+
+	a, _ := mutate.calc.round(m.amount, m.currency.get().Fraction, RoundHalfDown)
+	return &Money{amount: a, currency: m.currency}
+}
+
+// RoundWithMode returns new Money struct with value rounded to a whole major unit using the given rounding mode.
+func (m *Money) RoundWithMode(mode RoundingMode) (*Money, error) {
+	m = m.orZero()
+
+	a, err := mutate.calc.round(m.amount, m.currency.get().Fraction, mode)
+	if err != nil {
+		return nil, overflowed(err, "Round", m.currency)
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// RoundToIncrement returns new Money struct with value rounded to a multiple of increment subunits
+// using the given rounding mode, e.g. RoundToIncrement(5, RoundHalfUp) rounds CHF to 0.05.
+func (m *Money) RoundToIncrement(increment int64, mode RoundingMode) (*Money, error) {
+	m = m.orZero()
+
+	if increment <= 0 {
+		return nil, errors.New("increment must be higher than zero")
+	}
+
+	a, err := mutate.calc.roundToIncrement(m.amount, increment, mode)
+	if err != nil {
+		return nil, overflowed(err, "RoundToIncrement", m.currency)
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// RoundToCash returns new Money struct with value rounded to the currency's CashIncrement, or else
+// its CashFraction, using the given rounding mode, so it can be paid with the smallest coin in circulation.
+func (m *Money) RoundToCash(mode RoundingMode) (*Money, error) {
+	return m.RoundToIncrement(m.Currency().get().cashIncrement(), mode)
+}
+
+// RoundToAccounting returns new Money struct with value rounded to the currency's AccountingFraction
+// using the given rounding mode, e.g. IQD to whole dinars. The amount stays in subunits of the Fraction,
+// use Rescale to get it in subunits of the AccountingFraction.
+func (m *Money) RoundToAccounting(mode RoundingMode) (*Money, error) {
+	c := m.Currency().get()
+	return m.RoundToIncrement(c.increment(c.AccountingFraction), mode)
+}
+
+// Rescale returns the amount of Money in subunits of the given fraction, i.e. in units of 10^-fraction,
+// rounded using the given mode, e.g. Rescale(c.AccountingFraction, RoundHalfUp) or Rescale(c.Exponent("stripe"), RoundHalfEven).
+// It returns ErrOverflow when the rescaled amount doesn't fit into int64. See NewWithFraction for the way back.
+func (m *Money) Rescale(fraction int, mode RoundingMode) (int64, error) {
+	m = m.orZero()
+
+	a, err := rescale(m.amount, m.currency.get().Fraction, fraction, mode)
+	if err != nil {
+		return 0, overflowed(err, "Rescale", m.currency)
+	}
+
+	return a, nil
+}
+
+// rescale moves amount from subunits of the fraction from to subunits of the fraction to, rounding with mode.
+func rescale(amount Amount, from, to int, mode RoundingMode) (Amount, error) {
+	if from < 0 || to < 0 {
+		return 0, errors.New("fraction can't be negative")
+	}
+
+	d := to - from
+	switch {
+	case d == 0 || amount == 0:
+		return amount, nil
+	case d > 18:
+		// Any amount but zero is at least 10^19 subunits.
+		return 0, ErrOverflow
+	case d > 0:
+		p, _ := pow10Big(d)
+		return mutate.calc.multiplyRat(amount, new(big.Rat).SetInt(p), mode)
+	}
+
+	p, err := pow10Big(-d)
+	if err != nil {
+		return 0, err
+	}
+
+	return mutate.calc.multiplyRat(amount, new(big.Rat).SetFrac(big.NewInt(1), p), mode)
+}
+
+// Divide returns new Money struct with value representing Self divided by d, rounded using the given mode.
+// Dividing by zero returns ErrDivisionByZero.
+func (m *Money) Divide(d int64, mode RoundingMode) (*Money, error) {
+	m = m.orZero()
+
+	a, err := mutate.calc.divideRound(m.amount, d, mode)
+	if err != nil {
+		return nil, overflowed(err, "Divide", m.currency)
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// DivMod returns the quotient of Self divided by d truncated toward zero and the leftover subunits,
+// so that quotient * d + remainder equals Self. The remainder has the same sign as Self.
+// Dividing by zero returns ErrDivisionByZero.
+func (m *Money) DivMod(d int64) (*Money, *Money, error) {
+	m = m.orZero()
+
+	q, r, err := mutate.calc.divMod(m.amount, d)
+	if err != nil {
+		return nil, nil, overflowed(err, "DivMod", m.currency)
+	}
+
+	return &Money{amount: q, currency: m.currency}, &Money{amount: r, currency: m.currency}, nil
 }
 
 // Split returns slice of Money structs with split Self value in given number.
 // After division leftover pennies will be distributed round-robin amongst the parties.
 // This means that parties listed first will likely receive more pennies than ones that are listed later.
 func (m *Money) Split(n int) ([]*Money, error) {
+	return m.SplitWithStrategy(n, FirstParties{})
+}
+
+// SplitWithStrategy returns slice of Money structs with split Self value in given number.
+// After division leftover pennies will be distributed amongst the parties in the order chosen by the strategy.
+func (m *Money) SplitWithStrategy(n int, strategy AllocationStrategy) ([]*Money, error) {
+	m = m.orZero()
+
 	if n <= 0 {
 		return nil, errors.New("split must be higher than zero")
 	}
 
 	a := mutate.calc.divide(m.amount, int64(n))
 	ms := make([]*Money, n)
-
-	for i := 0; i < n; i++ {
-		ms[i] = &Money{amount: a, currency: m.currency}
-	}
+	shares := make([]Share, n)
 
 	r := mutate.calc.modulus(m.amount, int64(n))
 	l := mutate.calc.absolute(r)
-	// Add leftovers to the first parties.
 
-	v := int64(1)
-	if m.amount < 0 {
-		v = -1
+	for i := 0; i < n; i++ {
+		ms[i] = &Money{amount: a, currency: m.currency}
+		shares[i] = Share{Ratio: 1, Remainder: l}
 	}
-	for p := 0; l != 0; p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, v)
-		l--
+
+	give := func(p int, sub int64) { ms[p].amount += sub }
+	if err := distribute(shares, r, strategy, give); err != nil {
+		return nil, err
 	}
 
 	return ms, nil
@@ -291,6 +709,15 @@ func (m *Money) Split(n int) ([]*Money, error) {
 // It lets split money by given ratios without losing pennies and as Split operations distributes
 // leftover pennies amongst the parties with round-robin principle.
 func (m *Money) Allocate(rs ...int) ([]*Money, error) {
+	return m.AllocateWithStrategy(FirstParties{}, rs...)
+}
+
+// AllocateWithStrategy returns slice of Money structs with split Self value in given ratios.
+// It lets split money by given ratios without losing pennies and distributes leftover pennies
+// amongst the parties in the order chosen by the strategy.
+func (m *Money) AllocateWithStrategy(strategy AllocationStrategy, rs ...int) ([]*Money, error) {
+	m = m.orZero()
+
 	if len(rs) == 0 {
 		return nil, errors.New("no ratios specified")
 	}
@@ -309,14 +736,16 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 
 	var total int64
 	ms := make([]*Money, 0, len(rs))
+	shares := make([]Share, 0, len(rs))
 	for _, r := range rs {
-		party := &Money{
-			amount:   mutate.calc.allocate(m.amount, int64(r), sum),
-			currency: m.currency,
+		a, rem, err := mutate.calc.allocate(m.amount, int64(r), sum)
+		if err != nil {
+			return nil, overflowed(err, "Allocate", m.currency)
 		}
 
-		ms = append(ms, party)
-		total += party.amount
+		ms = append(ms, &Money{amount: a, currency: m.currency})
+		shares = append(shares, Share{Ratio: int64(r), Remainder: rem})
+		total += a
 	}
 
 	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
@@ -325,35 +754,152 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 		return ms, nil
 	}
 
-	// Calculate leftover value and divide to first parties.
-	lo := m.amount - total
-	sub := int64(1)
-	if lo < 0 {
-		sub = -sub
+	give := func(p int, sub int64) { ms[p].amount += sub }
+	if err := distribute(shares, m.amount-total, strategy, give); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
+
+// AllocateRat returns slice of Money structs with split Self value in given rational ratios.
+// Like Allocate it doesn't lose pennies and distributes leftover pennies amongst the parties listed first.
+func (m *Money) AllocateRat(rs ...*big.Rat) ([]*Money, error) {
+	return m.AllocateRatWithStrategy(FirstParties{}, rs...)
+}
+
+// AllocateRatWithStrategy returns slice of Money structs with split Self value in given rational ratios.
+// It doesn't lose pennies and distributes leftover pennies amongst the parties in the order chosen by the strategy,
+// see ratShares for the shares it sees.
+func (m *Money) AllocateRatWithStrategy(strategy AllocationStrategy, rs ...*big.Rat) ([]*Money, error) {
+	m = m.orZero()
+
+	if len(rs) == 0 {
+		return nil, errors.New("no ratios specified")
+	}
+
+	// Calculate sum of ratios.
+	sum := new(big.Rat)
+	for _, r := range rs {
+		if r == nil {
+			return nil, errors.New("nil ratios not allowed")
+		}
+		if r.Sign() < 0 {
+			return nil, errors.New("negative ratios not allowed")
+		}
+		sum.Add(sum, r)
 	}
 
-	for p := 0; lo != 0; p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, sub)
-		lo -= sub
+	var total int64
+	ms := make([]*Money, 0, len(rs))
+	for _, r := range rs {
+		a, err := mutate.calc.allocateRat(m.amount, r, sum)
+		if err != nil {
+			return nil, overflowed(err, "Allocate", m.currency)
+		}
+
+		ms = append(ms, &Money{amount: a, currency: m.currency})
+		total += a
+	}
+
+	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
+	// with the leftover
+	if sum.Sign() == 0 {
+		return ms, nil
+	}
+
+	give := func(p int, sub int64) { ms[p].amount += sub }
+	if err := distribute(ratShares(m.amount, rs), m.amount-total, strategy, give); err != nil {
+		return nil, err
 	}
 
 	return ms, nil
 }
 
+// ratShares returns the shares of the parties of an allocation of a by the rational ratios rs.
+// The ratios are expressed over their common denominator, and the remainders over the sum of those ratios.
+// When they don't fit into int64 they are all scaled down by the same power of two, which keeps their order
+// but can make close values equal.
+func ratShares(a Amount, rs []*big.Rat) []Share {
+	den := big.NewInt(1)
+	for _, r := range rs {
+		g := new(big.Int).GCD(nil, nil, den, r.Denom())
+		den.Mul(den, new(big.Int).Quo(r.Denom(), g))
+	}
+
+	ratios := make([]*big.Int, len(rs))
+	sum := new(big.Int)
+	for i, r := range rs {
+		ratios[i] = new(big.Int).Quo(new(big.Int).Mul(r.Num(), den), r.Denom())
+		sum.Add(sum, ratios[i])
+	}
+
+	shares := make([]Share, len(rs))
+	if sum.Sign() == 0 {
+		return shares
+	}
+
+	shift := uint(0)
+	if sum.BitLen() > 62 {
+		shift = uint(sum.BitLen() - 62)
+	}
+
+	mag := new(big.Int).SetUint64(mutate.calc.magnitude(a))
+	for i, r := range ratios {
+		rem := new(big.Int).Mul(mag, r)
+		rem.Mod(rem, sum)
+		ratio := new(big.Int).Rsh(r, shift).Int64()
+		if ratio == 0 && r.Sign() > 0 {
+			// Keep scaled down ratios apart from zero ratios, whose parties get no leftover.
+			ratio = 1
+		}
+
+		shares[i] = Share{Ratio: ratio, Remainder: rem.Rsh(rem, shift).Int64()}
+	}
+
+	return shares
+}
+
+// AllocateDecimal returns slice of Money structs with split Self value in given decimal ratios such as "33.3".
+// Like Allocate it doesn't lose pennies and distributes leftover pennies amongst the parties listed first.
+func (m *Money) AllocateDecimal(rs ...string) ([]*Money, error) {
+	return m.AllocateDecimalWithStrategy(FirstParties{}, rs...)
+}
+
+// AllocateDecimalWithStrategy returns slice of Money structs with split Self value in given decimal ratios
+// such as "33.3", distributing leftover pennies amongst the parties in the order chosen by the strategy.
+func (m *Money) AllocateDecimalWithStrategy(strategy AllocationStrategy, rs ...string) ([]*Money, error) {
+	rats := make([]*big.Rat, 0, len(rs))
+	for _, r := range rs {
+		rat, err := parseDecimal(r)
+		if err != nil {
+			return nil, err
+		}
+
+		rats = append(rats, rat)
+	}
+
+	return m.AllocateRatWithStrategy(strategy, rats...)
+}
+
 // Display lets represent Money struct as string in given Currency value.
 func (m *Money) Display() string {
-	c := m.currency.get()
-	return c.Formatter().Format(m.amount)
+	c := m.Currency().get()
+	return c.Formatter().Format(m.Amount())
 }
 
 // AsMajorUnits lets represent Money struct as subunits (float64) in given Currency value
 func (m *Money) AsMajorUnits() float64 {
-	c := m.currency.get()
-	return c.Formatter().ToMajorUnits(m.amount)
+	c := m.Currency().get()
+	return c.Formatter().ToMajorUnits(m.Amount())
 }
 
 // UnmarshalJSON is implementation of json.Unmarshaller
 func (m *Money) UnmarshalJSON(b []byte) error {
+	if m == nil {
+		return errors.New("can't unmarshal into nil Money")
+	}
+
 	return UnmarshalJSON(m, b)
 }
 
@@ -370,8 +916,8 @@ func (m Money) MarshalJSON() ([]byte, error) {
 //
 // If compare moneys from distinct currency, return (m.amount, ErrCurrencyMismatch)
 func (m *Money) Compare(om *Money) (int, error) {
-	if err := m.assertSameCurrency(om); err != nil {
-		return int(m.amount), err
+	if err := m.assertSameCurrency(om, "Compare"); err != nil {
+		return int(m.Amount()), err
 	}
 
 	return m.compare(om), nil
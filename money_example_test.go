@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/Rhymond/go-money"
+	"github.com/Rhymond/go-money/tag"
 )
 
 func ExampleMoney() {
@@ -57,7 +58,7 @@ func ExampleMoney_comparisons() {
 	// Output:
 	// false <nil>
 	// true <nil>
-	// false currencies don't match
+	// false money: Equals: currencies don't match (GBP != EUR)
 }
 
 func ExampleMoney_IsZero() {
@@ -116,6 +117,67 @@ func ExampleMoney_Multiply() {
 	// £2.00
 }
 
+func ExampleMoney_Percent() {
+	price := money.New(1999, "USD")
+
+	tax, err := price.Percent("7.25", money.RoundHalfUp)
+	fmt.Println(tax.Display(), err)
+
+	// Output:
+	// $1.45 <nil>
+}
+
+func ExampleMoney_MultiplyDecimal() {
+	euros := money.New(12345, "EUR")
+
+	// Convert with an exchange rate and round once at the end.
+	dollars, err := euros.MultiplyDecimal("1.0834", money.RoundHalfEven)
+	fmt.Println(money.New(dollars.Amount(), "USD").Display(), err)
+
+	// Output:
+	// $133.75 <nil>
+}
+
+func ExampleMoney_Divide() {
+	pound := money.New(100, "GBP")
+
+	result, err := pound.Divide(3, money.RoundHalfEven)
+	fmt.Println(result.Display(), err)
+
+	// Output:
+	// £0.33 <nil>
+}
+
+func ExampleMoney_DivMod() {
+	total := money.New(1000, "GBP")
+
+	unit, leftover, err := total.DivMod(3)
+	fmt.Println(unit.Display(), leftover.Display(), err)
+
+	// Output:
+	// £3.33 £0.01 <nil>
+}
+
+func ExampleMoney_RoundWithMode() {
+	pound := money.New(250, "GBP")
+
+	result, err := pound.RoundWithMode(money.RoundHalfEven)
+	fmt.Println(result.Display(), err)
+
+	// Output:
+	// £2.00 <nil>
+}
+
+func ExampleMoney_RoundToCash() {
+	francs := money.New(1023, "CHF")
+
+	result, err := francs.RoundToCash(money.RoundHalfUp)
+	fmt.Println(result.Display(), err)
+
+	// Output:
+	// 10.25 CHF <nil>
+}
+
 func ExampleMoney_Absolute() {
 	pound := money.New(-100, "GBP")
 
@@ -164,6 +226,24 @@ func ExampleMoney_Allocate() {
 	// £0.33
 }
 
+func ExampleMoney_AllocateWithStrategy() {
+	pound := money.New(100, "GBP")
+	parties, err := pound.AllocateWithStrategy(money.LargestRemainder{}, 10, 8, 9)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(parties[0].Display())
+	fmt.Println(parties[1].Display())
+	fmt.Println(parties[2].Display())
+
+	// Output:
+	// £0.37
+	// £0.30
+	// £0.33
+}
+
 func ExampleMoney_Display() {
 	fmt.Println(money.New(123456789, "EUR").Display())
 
@@ -178,3 +258,20 @@ func ExampleMoney_AsMajorUnits() {
 	// Output:
 	// 1234567.89
 }
+
+func ExampleTyped() {
+	price := money.NewTyped[tag.EUR](1999)
+	shipping := money.NewTyped[tag.EUR](500)
+
+	// Adding money.NewTyped[tag.USD](500) wouldn't compile.
+	total, err := price.Add(shipping)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(total.Display())
+
+	// Output:
+	// €24.99
+}
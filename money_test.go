@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
 	"testing"
 )
@@ -48,6 +49,79 @@ func TestNew_WithUnregisteredCurrency(t *testing.T) {
 	}
 }
 
+func TestNewStrict(t *testing.T) {
+	m, err := NewStrict(100, "eur")
+	if err != nil || m.Amount() != 100 || m.Currency().Code != EUR || m.Display() != "€1.00" {
+		t.Errorf("Expected €1.00 got %v, %v", m, err)
+	}
+
+	m, err = NewStrict(100, "EURO")
+	if m != nil || !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v, %v", ErrUnknownCurrency, m, err)
+	}
+
+	var e *ParseError
+	if !errors.As(err, &e) || e.Op != "NewStrict" || e.Input != "EURO" {
+		t.Errorf("Expected *ParseError of NewStrict got %#v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected int64
+		err      error
+	}{
+		{"12.34", EUR, 1234, nil},
+		{"-0.5", USD, -50, nil},
+		{"+7", GBP, 700, nil},
+		{"1234", JPY, 1234, nil},
+		{"1.234", BHD, 1234, nil},
+		{"92233720368547758.07", EUR, math.MaxInt64, nil},
+		{"92233720368547758.08", EUR, 0, ErrOverflow},
+		{"1", "EURO", 0, ErrUnknownCurrency},
+	}
+
+	for _, tc := range tcs {
+		m, err := Parse(tc.amount, tc.code)
+		if !errors.Is(err, tc.err) || (err == nil && m.Amount() != tc.expected) {
+			t.Errorf("Expected %s %s to be %d, %v got %v, %v", tc.amount, tc.code, tc.expected, tc.err, m, err)
+		}
+	}
+
+	for _, amount := range []string{"1.234", "1.5", "1e3", "", "."} {
+		if _, err := Parse(amount, JPY); err == nil {
+			t.Errorf("Expected an error parsing %q JPY", amount)
+		}
+	}
+}
+
+func TestStrict_UnmarshalJSON(t *testing.T) {
+	defer DefaultRegistry().SetStrict(false)
+
+	b := []byte(`{"amount": 100, "currency": "EURO"}`)
+	if err := defaultUnmarshalJSON(&Money{}, b); err != nil {
+		t.Errorf("Expected no error when not strict got %v", err)
+	}
+
+	DefaultRegistry().SetStrict(true)
+
+	if err := defaultUnmarshalJSON(&Money{}, b); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+
+	if err := (&BigMoney{}).UnmarshalJSON(b); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+
+	for _, s := range []string{`{"amount": 100, "currency": "eur"}`, `{"amount": 0, "currency": ""}`} {
+		if err := defaultUnmarshalJSON(&Money{}, []byte(s)); err != nil {
+			t.Errorf("Expected no error for %s got %v", s, err)
+		}
+	}
+}
+
 func TestCurrency(t *testing.T) {
 	code := "MOCK"
 	decimals := 5
@@ -107,7 +181,7 @@ func TestMoney_Equals_DifferentCurrencies(t *testing.T) {
 	usd := New(0, USD)
 
 	_, err := eur.Equals(usd)
-	if err == nil || !errors.Is(ErrCurrencyMismatch, err) {
+	if err == nil || !errors.Is(err, ErrCurrencyMismatch) {
 		t.Errorf("Expected Equals to return %q, got %v", ErrCurrencyMismatch.Error(), err)
 	}
 }
@@ -304,6 +378,286 @@ func TestMoney_Negative(t *testing.T) {
 	}
 }
 
+func TestMoney_Sign(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		expected int
+	}{
+		{-1, -1},
+		{0, 0},
+		{1, 1},
+		{math.MinInt64, -1},
+		{math.MaxInt64, 1},
+	}
+
+	for _, tc := range tcs {
+		if r := New(tc.amount, EUR).Sign(); r != tc.expected {
+			t.Errorf("Expected sign of %d to be %d got %d", tc.amount, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_CopySign(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		sign     int64
+		expected int64
+	}{
+		{100, -1, -100},
+		{-100, -1, -100},
+		{-100, 1, 100},
+		{100, 1, 100},
+		{-100, 0, 100},
+		{math.MinInt64, -1, math.MinInt64},
+		{math.MaxInt64, -5, -math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).CopySign(New(tc.sign, EUR))
+		if err != nil {
+			t.Errorf("Unexpected error copying sign of %d to %d: %v", tc.sign, tc.amount, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d with sign of %d to be %d got %d", tc.amount, tc.sign, tc.expected, r.amount)
+		}
+	}
+
+	if _, err := New(math.MinInt64, EUR).CopySign(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := New(1, EUR).CopySign(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoney_MinMax(t *testing.T) {
+	tcs := []struct {
+		amount1 int64
+		amount2 int64
+		min     int64
+		max     int64
+	}{
+		{1, 2, 1, 2},
+		{2, 1, 1, 2},
+		{-5, 5, -5, 5},
+		{3, 3, 3, 3},
+	}
+
+	for _, tc := range tcs {
+		m, om := New(tc.amount1, EUR), New(tc.amount2, EUR)
+
+		r, err := m.Min(om)
+		if err != nil || r.amount != tc.min {
+			t.Errorf("Expected min of %d and %d to be %d got %v, %v", tc.amount1, tc.amount2, tc.min, r, err)
+		}
+
+		if r == m || r == om {
+			t.Error("Expected Min to return a new Money")
+		}
+
+		r, err = m.Max(om)
+		if err != nil || r.amount != tc.max {
+			t.Errorf("Expected max of %d and %d to be %d got %v, %v", tc.amount1, tc.amount2, tc.max, r, err)
+		}
+	}
+
+	if _, err := New(1, EUR).Min(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := New(1, EUR).Max(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoney_Clamp(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		lo       int64
+		hi       int64
+		expected int64
+		between  bool
+	}{
+		{50, 100, 800, 100, false},
+		{100, 100, 800, 100, true},
+		{500, 100, 800, 500, true},
+		{800, 100, 800, 800, true},
+		{900, 100, 800, 800, false},
+		{-10, -5, -5, -5, false},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		lo, hi := New(tc.lo, EUR), New(tc.hi, EUR)
+
+		r, err := m.Clamp(lo, hi)
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d clamped to [%d, %d] to be %d got %v, %v", tc.amount, tc.lo, tc.hi, tc.expected, r, err)
+		}
+
+		b, err := m.IsBetween(lo, hi)
+		if err != nil || b != tc.between {
+			t.Errorf("Expected %d between [%d, %d] == %t got %t, %v", tc.amount, tc.lo, tc.hi, tc.between, b, err)
+		}
+	}
+
+	if _, err := New(1, EUR).Clamp(New(1, USD), New(2, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := New(1, EUR).IsBetween(New(1, EUR), New(2, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := New(1, EUR).Clamp(New(2, EUR), New(1, EUR)); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := New(1, EUR).IsBetween(New(2, EUR), New(1, EUR)); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_ZeroValue(t *testing.T) {
+	for _, z := range []*Money{{}, nil} {
+		if z.Amount() != 0 || z.Currency() != nil || !z.IsZero() || z.IsPositive() || z.IsNegative() || z.Sign() != 0 {
+			t.Errorf("Expected %#v to be zero without currency", z)
+		}
+
+		if r := z.Display(); r != "0.00" {
+			t.Errorf("Expected %#v to display as 0.00 got %s", z, r)
+		}
+
+		if r := z.AsMajorUnits(); r != 0 {
+			t.Errorf("Expected %#v as major units to be 0 got %f", z, r)
+		}
+
+		if v, err := z.Value(); err != nil || v != "0|" {
+			t.Errorf("Expected %#v value to be 0| got %v, %v", z, v, err)
+		}
+
+		if eq, err := z.Equals(&Money{}); err != nil || !eq {
+			t.Errorf("Expected %#v to equal the zero value got %t, %v", z, eq, err)
+		}
+
+		if _, err := z.Equals(New(0, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %v comparing with EUR got %v", ErrCurrencyMismatch, err)
+		}
+
+		// Add and Subtract adopt the currency of the other Money.
+		r, err := z.Add(New(100, EUR), New(50, EUR))
+		if err != nil || r.amount != 150 || r.currency.Code != EUR {
+			t.Errorf("Expected zero value + €1.00 + €0.50 to be €1.50 got %v, %v", r, err)
+		}
+
+		r, err = z.Subtract(New(100, EUR))
+		if err != nil || r.amount != -100 || r.currency.Code != EUR {
+			t.Errorf("Expected zero value - €1.00 to be -€1.00 got %v, %v", r, err)
+		}
+
+		r, err = New(100, EUR).Add(z, New(50, EUR))
+		if err != nil || r.amount != 150 || r.currency.Code != EUR {
+			t.Errorf("Expected €1.00 + zero value + €0.50 to be €1.50 got %v, %v", r, err)
+		}
+
+		r, err = New(100, EUR).Subtract(z)
+		if err != nil || r.amount != 100 || r.currency.Code != EUR {
+			t.Errorf("Expected €1.00 - zero value to be €1.00 got %v, %v", r, err)
+		}
+
+		r, err = z.Add(z, &Money{})
+		if err != nil || r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected the sum of zero values to be the zero value got %v, %v", r, err)
+		}
+
+		if _, err := z.Add(New(100, EUR), New(100, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %v adding EUR and USD got %v", ErrCurrencyMismatch, err)
+		}
+
+		if _, err := Sum(z, New(100, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Expected %v summing zero value and €1.00 got %v", ErrCurrencyMismatch, err)
+		}
+
+		// Other operations keep the zero value without currency.
+		if r := z.Multiply(5); r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected zero value * 5 to be the zero value got %v", r)
+		}
+
+		if r, err := z.MultiplyE(5); err != nil || r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected zero value * 5 to be the zero value got %v, %v", r, err)
+		}
+
+		if r, err := z.Percent("10", RoundHalfUp); err != nil || r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected 10%% of zero value to be the zero value got %v, %v", r, err)
+		}
+
+		if r, err := z.Divide(3, RoundHalfUp); err != nil || r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected zero value / 3 to be the zero value got %v, %v", r, err)
+		}
+
+		if _, err := z.Divide(0, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+			t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+		}
+
+		if q, rem, err := z.DivMod(3); err != nil || q.amount != 0 || rem.amount != 0 {
+			t.Errorf("Expected zero value divmod 3 to be zero got %v, %v, %v", q, rem, err)
+		}
+
+		if r, err := z.RoundWithMode(RoundHalfUp); err != nil || r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected rounded zero value to be the zero value got %v, %v", r, err)
+		}
+
+		if r, err := z.RoundToCash(RoundHalfUp); err != nil || r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected cash rounded zero value to be the zero value got %v, %v", r, err)
+		}
+
+		if r := z.Absolute(); r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected absolute zero value to be the zero value got %v", r)
+		}
+
+		if r := z.Negative(); r.amount != 0 || r.currency != nil {
+			t.Errorf("Expected negative zero value to be the zero value got %v", r)
+		}
+
+		if r, err := z.Clamp(&Money{}, &Money{}); err != nil || r.amount != 0 {
+			t.Errorf("Expected clamped zero value to be zero got %v, %v", r, err)
+		}
+
+		for _, split := range [][]*Money{
+			func() []*Money { r, _ := z.Split(3); return r }(),
+			func() []*Money { r, _ := z.Allocate(1, 2); return r }(),
+			func() []*Money { r, _ := z.AllocateDecimal("0.5", "0.5"); return r }(),
+		} {
+			if len(split) == 0 {
+				t.Errorf("Expected zero value to be split")
+			}
+
+			for _, p := range split {
+				if p.amount != 0 || p.currency != nil {
+					t.Errorf("Expected parts of zero value to be zero values got %v", p)
+				}
+			}
+		}
+
+		if b := z.Big(); b.Amount().Sign() != 0 || b.Currency() != nil {
+			t.Errorf("Expected zero value as BigMoney to be the zero value got %v", b)
+		}
+	}
+
+	b, err := json.Marshal(Money{})
+	if err != nil || string(b) != `{"amount":0,"currency":""}` {
+		t.Errorf("Expected zero value to marshal without currency got %s, %v", b, err)
+	}
+
+	var m Money
+	if err := json.Unmarshal(b, &m); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero value to unmarshal to the zero value got %+v, %v", m, err)
+	}
+}
+
 func TestMoney_Add(t *testing.T) {
 	tcs := []struct {
 		amount1  int64
@@ -507,6 +861,161 @@ func TestMoney_Multiply2(t *testing.T) {
 	}
 }
 
+func TestMoney_AddOverflow(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		expected int64
+		err      error
+	}{
+		{[]int64{math.MaxInt64, 0}, math.MaxInt64, nil},
+		{[]int64{math.MaxInt64 - 1, 1}, math.MaxInt64, nil},
+		{[]int64{math.MaxInt64, 1}, 0, ErrOverflow},
+		{[]int64{math.MaxInt64, math.MaxInt64}, 0, ErrOverflow},
+		{[]int64{math.MinInt64 + 1, -1}, math.MinInt64, nil},
+		{[]int64{math.MinInt64, -1}, 0, ErrOverflow},
+		{[]int64{math.MinInt64, math.MinInt64}, 0, ErrOverflow},
+		{[]int64{math.MinInt64, math.MaxInt64}, -1, nil},
+		{[]int64{0, math.MaxInt64, 1}, 0, ErrOverflow},
+		{[]int64{-1, math.MaxInt64, -1}, math.MaxInt64 - 2, nil},
+		{[]int64{-1, math.MaxInt64, 1}, math.MaxInt64, nil},
+		{[]int64{1, math.MinInt64, -1}, math.MinInt64, nil},
+		{[]int64{math.MaxInt64, math.MaxInt64, math.MinInt64, math.MinInt64}, -2, nil},
+		{[]int64{math.MaxInt64, math.MaxInt64, math.MinInt64}, math.MaxInt64 - 1, nil},
+		{[]int64{math.MaxInt64, math.MaxInt64, -1}, 0, ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amounts[0], EUR)
+		var oms []*Money
+		for _, a := range tc.amounts[1:] {
+			oms = append(oms, New(a, EUR))
+		}
+
+		r, err := m.Add(oms...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected sum of %v to return error %v got %v", tc.amounts, tc.err, err)
+			continue
+		}
+
+		if err == nil && r.amount != tc.expected {
+			t.Errorf("Expected sum of %v to be %d got %d", tc.amounts, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_SubtractOverflow(t *testing.T) {
+	tcs := []struct {
+		amount1  int64
+		amount2  int64
+		expected int64
+		err      error
+	}{
+		{math.MaxInt64, -1, 0, ErrOverflow},
+		{math.MaxInt64, 1, math.MaxInt64 - 1, nil},
+		{math.MinInt64, 1, 0, ErrOverflow},
+		{math.MinInt64 + 1, 1, math.MinInt64, nil},
+		{math.MinInt64, -1, math.MinInt64 + 1, nil},
+		{0, math.MinInt64, 0, ErrOverflow},
+		{-1, math.MinInt64, math.MaxInt64, nil},
+		{0, math.MaxInt64, -math.MaxInt64, nil},
+		{math.MinInt64, math.MinInt64, 0, nil},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount1, EUR).Subtract(New(tc.amount2, EUR))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %d - %d to return error %v got %v", tc.amount1, tc.amount2, tc.err, err)
+			continue
+		}
+
+		if err == nil && r.amount != tc.expected {
+			t.Errorf("Expected %d - %d = %d got %d", tc.amount1, tc.amount2, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_SubtractManyOverflow(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		expected int64
+		err      error
+	}{
+		{[]int64{math.MaxInt64, math.MaxInt64, 1}, -1, nil},
+		{[]int64{math.MinInt64, math.MinInt64, -1}, 1, nil},
+		{[]int64{0, math.MinInt64, -1}, 0, ErrOverflow},
+		{[]int64{-2, math.MaxInt64, 1}, 0, ErrOverflow},
+		{[]int64{-2, math.MaxInt64, -1}, math.MinInt64, nil},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amounts[0], EUR)
+		var oms []*Money
+		for _, a := range tc.amounts[1:] {
+			oms = append(oms, New(a, EUR))
+		}
+
+		r, err := m.Subtract(oms...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected difference of %v to return error %v got %v", tc.amounts, tc.err, err)
+			continue
+		}
+
+		if err == nil && r.amount != tc.expected {
+			t.Errorf("Expected difference of %v to be %d got %d", tc.amounts, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_MultiplyE(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		muls     []int64
+		expected int64
+		err      error
+	}{
+		{5, []int64{5}, 25, nil},
+		{10, []int64{5, -3}, -150, nil},
+		{math.MaxInt64, []int64{1}, math.MaxInt64, nil},
+		{math.MaxInt64, []int64{-1}, -math.MaxInt64, nil},
+		{math.MaxInt64, []int64{2}, 0, ErrOverflow},
+		{math.MaxInt64 / 2, []int64{2}, math.MaxInt64 - 1, nil},
+		{math.MaxInt64/2 + 1, []int64{2}, 0, ErrOverflow},
+		{math.MinInt64, []int64{1}, math.MinInt64, nil},
+		{math.MinInt64, []int64{-1}, 0, ErrOverflow},
+		{-1, []int64{math.MinInt64}, 0, ErrOverflow},
+		{math.MinInt64 / 2, []int64{2}, math.MinInt64, nil},
+		{math.MinInt64 / 2, []int64{-2}, 0, ErrOverflow},
+		{1 << 31, []int64{1 << 31}, 1 << 62, nil},
+		{1 << 32, []int64{1 << 31}, 0, ErrOverflow},
+		{3, []int64{math.MaxInt64, 0}, 0, nil},
+		{0, []int64{math.MaxInt64, math.MaxInt64}, 0, nil},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).MultiplyE(tc.muls...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %d * %v to return error %v got %v", tc.amount, tc.muls, tc.err, err)
+			continue
+		}
+
+		if err == nil && r.amount != tc.expected {
+			t.Errorf("Expected %d * %v = %d got %d", tc.amount, tc.muls, tc.expected, r.amount)
+		}
+	}
+
+	if _, err := New(100, EUR).MultiplyE(); err == nil {
+		t.Error("Expected error without multipliers")
+	}
+}
+
+func TestMoney_MultiplyWraps(t *testing.T) {
+	r := New(math.MaxInt64, EUR).Multiply(2)
+
+	if r.amount != -2 {
+		t.Errorf("Expected wrapped product %d got %d", -2, r.amount)
+	}
+}
+
 func TestMoney_Round(t *testing.T) {
 	tcs := []struct {
 		amount   int64
@@ -550,45 +1059,492 @@ func TestMoney_RoundWithExponential(t *testing.T) {
 	}
 }
 
-func TestMoney_Split(t *testing.T) {
+func TestMoney_MultiplyRat(t *testing.T) {
 	tcs := []struct {
 		amount   int64
-		split    int
-		expected []int64
+		factor   *big.Rat
+		mode     RoundingMode
+		expected int64
 	}{
-		{100, 3, []int64{34, 33, 33}},
-		{100, 4, []int64{25, 25, 25, 25}},
-		{5, 3, []int64{2, 2, 1}},
-		{-101, 4, []int64{-26, -25, -25, -25}},
-		{-101, 4, []int64{-26, -25, -25, -25}},
-		{-2, 3, []int64{-1, -1, 0}},
+		{100, big.NewRat(1, 3), RoundHalfUp, 33},
+		{100, big.NewRat(2, 3), RoundHalfUp, 67},
+		{100, big.NewRat(2, 3), RoundDown, 66},
+		{-100, big.NewRat(2, 3), RoundHalfUp, -67},
+		{-100, big.NewRat(2, 3), RoundCeiling, -66},
+		{5, big.NewRat(1, 2), RoundHalfEven, 2},
+		{15, big.NewRat(1, 2), RoundHalfEven, 8},
+		{-5, big.NewRat(1, 2), RoundHalfDown, -2},
+		{-5, big.NewRat(1, 2), RoundHalfUp, -3},
+		{100, big.NewRat(-1, 3), RoundFloor, -34},
+		{math.MaxInt64, big.NewRat(3, 3), RoundHalfUp, math.MaxInt64},
+		{math.MaxInt64, big.NewRat(2, 3), RoundHalfUp, 6148914691236517205},
+		{math.MinInt64, big.NewRat(1, 1), RoundHalfUp, math.MinInt64},
+		{0, big.NewRat(7, 3), RoundUp, 0},
 	}
 
 	for _, tc := range tcs {
-		m := New(tc.amount, EUR)
-		var rs []int64
-		split, _ := m.Split(tc.split)
-
-		for _, party := range split {
-			rs = append(rs, party.amount)
+		r, err := New(tc.amount, EUR).MultiplyRat(tc.factor, tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error multiplying %d by %v: %v", tc.amount, tc.factor, err)
+			continue
 		}
 
-		if !reflect.DeepEqual(tc.expected, rs) {
-			t.Errorf("Expected split of %d to be %v got %v", tc.amount, tc.expected, rs)
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d * %v with %v = %d got %d", tc.amount, tc.factor, tc.mode, tc.expected, r.amount)
 		}
 	}
 }
 
-func TestMoney_Split2(t *testing.T) {
-	m := New(100, EUR)
-	r, err := m.Split(-10)
+func TestMoney_MultiplyRat2(t *testing.T) {
+	if _, err := New(math.MaxInt64, EUR).MultiplyRat(big.NewRat(3, 2), RoundDown); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
 
-	if r != nil || err == nil {
-		t.Error("Expected err")
+	if _, err := New(math.MinInt64, EUR).MultiplyRat(big.NewRat(-1, 1), RoundDown); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
 	}
-}
 
-func TestMoney_Allocate(t *testing.T) {
+	if _, err := New(100, EUR).MultiplyRat(big.NewRat(1, 1), RoundingMode(-1)); !errors.Is(err, ErrInvalidRoundingMode) {
+		t.Errorf("Expected %v got %v", ErrInvalidRoundingMode, err)
+	}
+
+	if _, err := New(100, EUR).MultiplyRat(nil, RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_MultiplyDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		factor   string
+		mode     RoundingMode
+		expected int64
+		wantErr  bool
+	}{
+		{10000, "1.0834", RoundHalfUp, 10834, false},
+		{12345, "1.0834", RoundHalfUp, 13375, false},
+		{12345, "1.0834", RoundDown, 13374, false},
+		{12345, "-1.0834", RoundHalfEven, -13375, false},
+		{100, "+0.5", RoundHalfUp, 50, false},
+		{100, ".5", RoundHalfUp, 50, false},
+		{100, "2.", RoundHalfUp, 200, false},
+		{100, "0.005", RoundHalfEven, 0, false},
+		{300, "0.005", RoundHalfEven, 2, false},
+		{100, "", RoundHalfUp, 0, true},
+		{100, ".", RoundHalfUp, 0, true},
+		{100, "1/3", RoundHalfUp, 0, true},
+		{100, "1e3", RoundHalfUp, 0, true},
+		{100, "1.2.3", RoundHalfUp, 0, true},
+		{100, "1-", RoundHalfUp, 0, true},
+		{100, "abc", RoundHalfUp, 0, true},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).MultiplyDecimal(tc.factor, tc.mode)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("Expected %d * %q error %t got %v", tc.amount, tc.factor, tc.wantErr, err)
+			continue
+		}
+
+		if err == nil && r.amount != tc.expected {
+			t.Errorf("Expected %d * %q with %v = %d got %d", tc.amount, tc.factor, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_Percent(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		percent  string
+		mode     RoundingMode
+		expected int64
+	}{
+		{10000, "7.25", RoundHalfUp, 725},
+		{1999, "7.25", RoundHalfUp, 145},
+		{1999, "7.25", RoundDown, 144},
+		{1000, "100", RoundHalfUp, 1000},
+		{1000, "-20", RoundHalfUp, -200},
+		{50, "1", RoundHalfEven, 0},
+		{150, "1", RoundHalfEven, 2},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).Percent(tc.percent, tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error taking %s%% of %d: %v", tc.percent, tc.amount, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %s%% of %d with %v = %d got %d", tc.percent, tc.amount, tc.mode, tc.expected, r.amount)
+		}
+	}
+
+	if _, err := New(100, EUR).Percent("ten", RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_RoundWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{150, RoundHalfUp, 200},
+		{149, RoundHalfUp, 100},
+		{-150, RoundHalfUp, -200},
+		{-149, RoundHalfUp, -100},
+		{150, RoundHalfDown, 100},
+		{151, RoundHalfDown, 200},
+		{-150, RoundHalfDown, -100},
+		{-151, RoundHalfDown, -200},
+		{150, RoundHalfEven, 200},
+		{250, RoundHalfEven, 200},
+		{251, RoundHalfEven, 300},
+		{-250, RoundHalfEven, -200},
+		{-350, RoundHalfEven, -400},
+		{101, RoundCeiling, 200},
+		{-199, RoundCeiling, -100},
+		{199, RoundFloor, 100},
+		{-101, RoundFloor, -200},
+		{101, RoundUp, 200},
+		{-101, RoundUp, -200},
+		{199, RoundDown, 100},
+		{-199, RoundDown, -100},
+		{0, RoundUp, 0},
+		{300, RoundUp, 300},
+		{-300, RoundFloor, -300},
+		{math.MaxInt64, RoundDown, math.MaxInt64 - 7},
+		{math.MinInt64, RoundDown, math.MinInt64 + 8},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).RoundWithMode(tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error rounding %d with %v: %v", tc.amount, tc.mode, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d rounded with %v to be %d got %d", tc.amount, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_RoundWithMode2(t *testing.T) {
+	if _, err := New(math.MaxInt64, EUR).RoundWithMode(RoundUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := New(math.MinInt64, EUR).RoundWithMode(RoundFloor); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := New(100, EUR).RoundWithMode(RoundingMode(42)); !errors.Is(err, ErrInvalidRoundingMode) {
+		t.Errorf("Expected %v got %v", ErrInvalidRoundingMode, err)
+	}
+}
+
+func TestMoney_RoundToIncrement(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		increment int64
+		mode      RoundingMode
+		expected  int64
+	}{
+		{1002, 5, RoundHalfUp, 1000},
+		{1003, 5, RoundHalfUp, 1005},
+		{-1003, 5, RoundHalfUp, -1005},
+		{1025, 50, RoundHalfUp, 1050},
+		{1025, 50, RoundHalfDown, 1000},
+		{1075, 50, RoundHalfEven, 1100},
+		{1001, 5, RoundCeiling, 1005},
+		{1004, 5, RoundFloor, 1000},
+		{1049, 100, RoundHalfUp, 1000},
+		{1050, 100, RoundHalfUp, 1100},
+		{1050, 1, RoundUp, 1050},
+		{math.MaxInt64, 5, RoundDown, math.MaxInt64 - 2},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, CHF).RoundToIncrement(tc.increment, tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error rounding %d to %d: %v", tc.amount, tc.increment, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d rounded to %d with %v to be %d got %d", tc.amount, tc.increment, tc.mode,
+				tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_RoundToIncrement2(t *testing.T) {
+	if _, err := New(100, CHF).RoundToIncrement(0, RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := New(100, CHF).RoundToIncrement(-5, RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := New(math.MaxInt64, CHF).RoundToIncrement(5, RoundUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_RoundToCash(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected int64
+	}{
+		{1023, CHF, 1025},
+		{1022, CHF, 1020},
+		{1022, CAD, 1020},
+		{1024, DKK, 1000},
+		{1025, DKK, 1050},
+		{1049, SEK, 1000},
+		{1050, SEK, 1100},
+		{1023, EUR, 1023},
+		{1023, "FOO", 1023},
+		{1049, HUF, 1000},
+		{1250, HUF, 1500},
+		{1049, COP, 1000},
+		{1050, COP, 1100},
+		{-1050, TWD, -1100},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, tc.code).RoundToCash(RoundHalfUp)
+		if err != nil {
+			t.Errorf("Unexpected error rounding %d %s to cash: %v", tc.amount, tc.code, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d %s rounded to cash to be %d got %d", tc.amount, tc.code, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_RoundToAccounting(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		mode     RoundingMode
+		expected int64
+	}{
+		{12345, IQD, RoundHalfUp, 12000},
+		{12500, IQD, RoundHalfUp, 13000},
+		{12500, IQD, RoundHalfEven, 12000},
+		{-150, ALL, RoundHalfUp, -200},
+		{1023, EUR, RoundHalfUp, 1023},
+		{1023, HUF, RoundHalfUp, 1023},
+		{1023, "FOO", RoundHalfUp, 1023},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, tc.code).RoundToAccounting(tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error rounding %d %s to accounting: %v", tc.amount, tc.code, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d %s rounded to accounting to be %d got %d", tc.amount, tc.code, tc.expected, r.amount)
+		}
+	}
+
+	a, err := New(12500, IQD).Rescale(GetCurrency(IQD).AccountingFraction, RoundHalfUp)
+	if err != nil || a != 13 {
+		t.Errorf("Expected 13 got %d, %v", a, err)
+	}
+}
+
+func TestMoney_Divide(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		divisor  int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{100, 4, RoundDown, 25},
+		{100, 3, RoundDown, 33},
+		{100, 3, RoundUp, 34},
+		{-100, 3, RoundUp, -34},
+		{100, -3, RoundCeiling, -33},
+		{100, -3, RoundFloor, -34},
+		{5, 2, RoundHalfUp, 3},
+		{5, 2, RoundHalfDown, 2},
+		{5, 2, RoundHalfEven, 2},
+		{7, 2, RoundHalfEven, 4},
+		{-5, 2, RoundHalfEven, -2},
+		{-7, 2, RoundHalfEven, -4},
+		{-5, -2, RoundHalfUp, 3},
+		{math.MaxInt64, 2, RoundHalfUp, math.MaxInt64/2 + 1},
+		{math.MinInt64, 1, RoundUp, math.MinInt64},
+		{math.MinInt64, math.MaxInt64, RoundHalfEven, -1},
+		{math.MaxInt64, math.MinInt64, RoundHalfUp, -1},
+		{1, math.MinInt64, RoundHalfUp, 0},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).Divide(tc.divisor, tc.mode)
+		if err != nil {
+			t.Errorf("Unexpected error dividing %d by %d: %v", tc.amount, tc.divisor, err)
+			continue
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %d / %d with %v = %d got %d", tc.amount, tc.divisor, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_Divide2(t *testing.T) {
+	if _, err := New(100, EUR).Divide(0, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+
+	if _, err := New(math.MinInt64, EUR).Divide(-1, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_DivMod(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		divisor   int64
+		quotient  int64
+		remainder int64
+	}{
+		{100, 3, 33, 1},
+		{100, 4, 25, 0},
+		{-100, 3, -33, -1},
+		{100, -3, -33, 1},
+		{-100, -3, 33, -1},
+		{2, 3, 0, 2},
+		{math.MaxInt64, 2, math.MaxInt64 / 2, 1},
+		{math.MinInt64, 2, math.MinInt64 / 2, 0},
+		{math.MinInt64, math.MaxInt64, -1, -1},
+		{math.MaxInt64, math.MinInt64, 0, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		q, r, err := New(tc.amount, EUR).DivMod(tc.divisor)
+		if err != nil {
+			t.Errorf("Unexpected error dividing %d by %d: %v", tc.amount, tc.divisor, err)
+			continue
+		}
+
+		if q.amount != tc.quotient || r.amount != tc.remainder {
+			t.Errorf("Expected %d divmod %d = (%d, %d) got (%d, %d)", tc.amount, tc.divisor,
+				tc.quotient, tc.remainder, q.amount, r.amount)
+		}
+
+		if q.Currency().Code != EUR || r.Currency().Code != EUR {
+			t.Errorf("Expected currency %s got %s and %s", EUR, q.Currency().Code, r.Currency().Code)
+		}
+	}
+}
+
+func TestMoney_DivMod2(t *testing.T) {
+	q, r, err := New(100, EUR).DivMod(0)
+	if q != nil || r != nil || !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+
+	q, r, err = New(math.MinInt64, EUR).DivMod(-1)
+	if q != nil || r != nil || !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_Split(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		split    int
+		expected []int64
+	}{
+		{100, 3, []int64{34, 33, 33}},
+		{100, 4, []int64{25, 25, 25, 25}},
+		{5, 3, []int64{2, 2, 1}},
+		{-101, 4, []int64{-26, -25, -25, -25}},
+		{-101, 4, []int64{-26, -25, -25, -25}},
+		{-2, 3, []int64{-1, -1, 0}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		var rs []int64
+		split, _ := m.Split(tc.split)
+
+		for _, party := range split {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected split of %d to be %v got %v", tc.amount, tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_SplitWithStrategy(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		split    int
+		strategy AllocationStrategy
+		expected []int64
+	}{
+		{100, 3, FirstParties{}, []int64{34, 33, 33}},
+		{100, 3, LastParties{}, []int64{33, 33, 34}},
+		{-101, 4, LastParties{}, []int64{-25, -25, -25, -26}},
+		{5, 3, LargestRemainder{}, []int64{2, 2, 1}},
+		{5, 3, LargestRatio{}, []int64{2, 2, 1}},
+		{100, 4, SeededRandom{Seed: 1}, []int64{25, 25, 25, 25}},
+	}
+
+	for _, tc := range tcs {
+		split, err := New(tc.amount, EUR).SplitWithStrategy(tc.split, tc.strategy)
+		if err != nil {
+			t.Errorf("Unexpected error splitting %d with %T: %v", tc.amount, tc.strategy, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range split {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected split of %d with %T to be %v got %v", tc.amount, tc.strategy, tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_Split2(t *testing.T) {
+	m := New(100, EUR)
+	r, err := m.Split(-10)
+
+	if r != nil || err == nil {
+		t.Error("Expected err")
+	}
+
+	n := 1<<20 + 1
+	ms, err := m.Split(n)
+	if err != nil || len(ms) != n {
+		t.Fatalf("Expected split in %d parties got %d, %v", n, len(ms), err)
+	}
+
+	if sum, err := Sum(ms...); err != nil || sum.amount != 100 {
+		t.Errorf("Expected split in %d parties to add up to 100 got %v, %v", n, sum, err)
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
 	tcs := []struct {
 		amount   int64
 		ratios   []int
@@ -620,6 +1576,228 @@ func TestMoney_Allocate(t *testing.T) {
 	}
 }
 
+func TestMoney_AllocateWithStrategy(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		strategy AllocationStrategy
+		ratios   []int
+		expected []int64
+	}{
+		{100, FirstParties{}, []int{1, 1, 1}, []int64{34, 33, 33}},
+		{100, LastParties{}, []int{1, 1, 1}, []int64{33, 33, 34}},
+		{101, LastParties{}, []int{1, 1, 1}, []int64{33, 34, 34}},
+		{-101, LastParties{}, []int{1, 1, 1}, []int64{-33, -34, -34}},
+		{100, LargestRemainder{}, []int{1, 1, 1}, []int64{34, 33, 33}},
+		// 100 * 10/27 = 37.04, 100 * 8/27 = 29.63, 100 * 9/27 = 33.33
+		{100, LargestRemainder{}, []int{10, 8, 9}, []int64{37, 30, 33}},
+		{100, FirstParties{}, []int{10, 8, 9}, []int64{38, 29, 33}},
+		{100, LargestRatio{}, []int{10, 8, 9}, []int64{38, 29, 33}},
+		{100, LargestRatio{}, []int{8, 9, 10}, []int64{29, 33, 38}},
+		{-100, LargestRemainder{}, []int{10, 8, 9}, []int64{-37, -30, -33}},
+		// 5 * 1/6 = 0.83, 5 * 2/6 = 1.67, 5 * 3/6 = 2.5
+		{5, LargestRemainder{}, []int{1, 2, 3}, []int64{1, 2, 2}},
+		{5, LargestRatio{}, []int{1, 2, 3}, []int64{0, 2, 3}},
+		{10, LastParties{}, []int{0, 0}, []int64{0, 0}},
+		{0, LargestRemainder{}, []int{1, 1, 1}, []int64{0, 0, 0}},
+		// Parties with a zero ratio get no leftover, whatever the strategy.
+		{3, LastParties{}, []int{1, 1, 0}, []int64{1, 2, 0}},
+		{-3, FirstParties{}, []int{0, 1, 1}, []int64{0, -2, -1}},
+		{3, SeededRandom{Seed: 1}, []int{0, 1, 0, 1, 0}, []int64{0, 2, 0, 1, 0}},
+	}
+
+	for _, tc := range tcs {
+		parties, err := New(tc.amount, EUR).AllocateWithStrategy(tc.strategy, tc.ratios...)
+		if err != nil {
+			t.Errorf("Unexpected error allocating %d for ratios %v with %T: %v", tc.amount, tc.ratios, tc.strategy, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range parties {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for ratios %v with %T to be %v got %v", tc.amount, tc.ratios,
+				tc.strategy, tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_AllocateWithStrategy2(t *testing.T) {
+	m := New(100, EUR)
+
+	a, err := m.AllocateWithStrategy(SeededRandom{Seed: 42}, 1, 1, 1, 1, 1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := m.AllocateWithStrategy(SeededRandom{Seed: 42}, 1, 1, 1, 1, 1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sum int64
+	for i := range a {
+		if a[i].amount != b[i].amount {
+			t.Errorf("Expected the same seed to allocate the same amounts, got %d and %d for party %d", a[i].amount, b[i].amount, i)
+		}
+
+		sum += a[i].amount
+	}
+
+	if sum != 100 {
+		t.Errorf("Expected allocated amounts to sum to %d got %d", 100, sum)
+	}
+
+	if _, err := m.AllocateWithStrategy(nil, 1, 1, 1); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := m.AllocateWithStrategy(brokenStrategy{}, 1, 1, 1); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+type brokenStrategy struct{}
+
+func (brokenStrategy) Order(shares []Share) []int {
+	return []int{0, 0}
+}
+
+func TestMoney_AllocateRat(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []*big.Rat
+		expected []int64
+	}{
+		{100, []*big.Rat{big.NewRat(1, 2), big.NewRat(1, 2)}, []int64{50, 50}},
+		{100, []*big.Rat{big.NewRat(1, 3), big.NewRat(2, 3)}, []int64{34, 66}},
+		{100, []*big.Rat{big.NewRat(1, 8), big.NewRat(7, 8)}, []int64{13, 87}},
+		{-100, []*big.Rat{big.NewRat(1, 3), big.NewRat(1, 3), big.NewRat(1, 3)}, []int64{-34, -33, -33}},
+		{10, []*big.Rat{big.NewRat(0, 1), big.NewRat(1, 7)}, []int64{0, 10}},
+		{10, []*big.Rat{big.NewRat(0, 1), big.NewRat(0, 1)}, []int64{0, 0}},
+		{math.MaxInt64, []*big.Rat{big.NewRat(math.MaxInt64, 1), big.NewRat(math.MaxInt64, 1)},
+			[]int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+		{math.MinInt64, []*big.Rat{big.NewRat(1, math.MaxInt64), big.NewRat(0, 1)}, []int64{math.MinInt64, 0}},
+	}
+
+	for _, tc := range tcs {
+		parties, err := New(tc.amount, EUR).AllocateRat(tc.ratios...)
+		if err != nil {
+			t.Errorf("Unexpected error allocating %d for ratios %v: %v", tc.amount, tc.ratios, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range parties {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v", tc.amount, tc.ratios,
+				tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_AllocateRat2(t *testing.T) {
+	m := New(100, EUR)
+
+	if _, err := m.AllocateRat(); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := m.AllocateRat(big.NewRat(1, 2), nil); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := m.AllocateRat(big.NewRat(1, 2), big.NewRat(-1, 2)); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_AllocateRatWithStrategy(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []string
+		strategy AllocationStrategy
+		expected []int64
+	}{
+		{7, []string{"0.1", "0.2", "0.7"}, FirstParties{}, []int64{1, 2, 4}},
+		{7, []string{"0.1", "0.2", "0.7"}, LastParties{}, []int64{0, 2, 5}},
+		{7, []string{"0.1", "0.2", "0.7"}, LargestRemainder{}, []int64{1, 1, 5}},
+		{7, []string{"0.1", "0.2", "0.7"}, LargestRatio{}, []int64{0, 2, 5}},
+		{-7, []string{"0.1", "0.2", "0.7"}, LargestRemainder{}, []int64{-1, -1, -5}},
+		{100, []string{"0.125", "0.125", "0.125"}, LastParties{}, []int64{33, 33, 34}},
+		{3, []string{"1", "1", "0"}, LastParties{}, []int64{1, 2, 0}},
+	}
+
+	for _, tc := range tcs {
+		parties, err := New(tc.amount, EUR).AllocateDecimalWithStrategy(tc.strategy, tc.ratios...)
+		if err != nil {
+			t.Errorf("Unexpected error allocating %d for ratios %v: %v", tc.amount, tc.ratios, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range parties {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for ratios %v with %T to be %v got %v", tc.amount, tc.ratios,
+				tc.strategy, tc.expected, rs)
+		}
+	}
+
+	// Ratios whose common denominator doesn't fit into int64 are scaled down for the strategy.
+	rs := []*big.Rat{big.NewRat(1, math.MaxInt64), big.NewRat(1, math.MaxInt64-1), big.NewRat(1, 3)}
+	parties, err := New(5, EUR).AllocateRatWithStrategy(LargestRemainder{}, rs...)
+	if err != nil || parties[0].amount+parties[1].amount+parties[2].amount != 5 {
+		t.Errorf("Expected allocation of 5 without losing pennies got %v %v", parties, err)
+	}
+
+	if _, err := New(5, EUR).AllocateRatWithStrategy(nil, big.NewRat(1, 3), big.NewRat(2, 3)); err == nil {
+		t.Error("Expected error for a nil strategy")
+	}
+}
+
+func TestMoney_AllocateDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []string
+		expected []int64
+		wantErr  bool
+	}{
+		{100, []string{"33.3", "66.7"}, []int64{34, 66}, false},
+		{1000, []string{"33.3", "66.7"}, []int64{333, 667}, false},
+		{100, []string{"0.125", "0.875"}, []int64{13, 87}, false},
+		{100, []string{"0.125", "0.125", "0.125"}, []int64{34, 33, 33}, false},
+		{100, []string{"1", "x"}, nil, true},
+		{100, []string{"1", "-1"}, nil, true},
+		{100, nil, nil, true},
+	}
+
+	for _, tc := range tcs {
+		parties, err := New(tc.amount, EUR).AllocateDecimal(tc.ratios...)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("Expected allocation of %d for ratios %v error %t got %v", tc.amount, tc.ratios, tc.wantErr, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range parties {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v", tc.amount, tc.ratios,
+				tc.expected, rs)
+		}
+	}
+}
+
 func TestMoney_Allocate2(t *testing.T) {
 	m := New(100, EUR)
 	r, err := m.Allocate()
@@ -642,6 +1820,39 @@ func TestAllocateOverflow(t *testing.T) {
 	}
 }
 
+func TestMoney_AllocateBoundaries(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []int
+		expected []int64
+	}{
+		{math.MaxInt64, []int{1, 1}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+		{math.MinInt64, []int{1, 1}, []int64{math.MinInt64 / 2, math.MinInt64 / 2}},
+		{math.MaxInt64, []int{math.MaxInt32, 1}, []int64{9223372032559808512, 4294967295}},
+		{math.MinInt64, []int{1, 0}, []int64{math.MinInt64, 0}},
+		{math.MaxInt64, []int{math.MaxInt64 - 1, 1}, []int64{math.MaxInt64 - 1, 1}},
+		{-math.MaxInt64, []int{3, 3, 3}, []int64{-3074457345618258603, -3074457345618258602, -3074457345618258602}},
+	}
+
+	for _, tc := range tcs {
+		parties, err := New(tc.amount, EUR).Allocate(tc.ratios...)
+		if err != nil {
+			t.Errorf("Unexpected error allocating %d for ratios %v: %v", tc.amount, tc.ratios, err)
+			continue
+		}
+
+		var rs []int64
+		for _, party := range parties {
+			rs = append(rs, party.amount)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v", tc.amount, tc.ratios,
+				tc.expected, rs)
+		}
+	}
+}
+
 func TestMoney_Format(t *testing.T) {
 	tcs := []struct {
 		amount   int64
@@ -766,7 +1977,7 @@ func TestMoney_Comparison(t *testing.T) {
 			twoPounds.amount, -1, r)
 	}
 
-	if _, err := pound.Compare(twoEuros); err != ErrCurrencyMismatch {
+	if _, err := pound.Compare(twoEuros); !errors.Is(err, ErrCurrencyMismatch) {
 		t.Error("Expected err")
 	}
 
@@ -955,3 +2166,78 @@ func TestCustomUnmarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, m.Display())
 	}
 }
+
+func TestMoney_Rescale(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		fraction int
+		mode     RoundingMode
+		expected int64
+		err      error
+	}{
+		{12345, HUF, 0, RoundHalfUp, 123, nil},
+		{12350, HUF, 0, RoundHalfUp, 124, nil},
+		{12350, HUF, 0, RoundHalfEven, 124, nil},
+		{12250, HUF, 0, RoundHalfEven, 122, nil},
+		{-12350, HUF, 0, RoundHalfUp, -124, nil},
+		{12301, TWD, 0, RoundUp, 124, nil},
+		{12345, EUR, 2, RoundDown, 12345, nil},
+		{12345, EUR, 4, RoundDown, 1234500, nil},
+		{100, JPY, 2, RoundHalfUp, 10000, nil},
+		{1, EUR, 21, RoundHalfUp, 0, ErrOverflow},
+		{1, EUR, 20, RoundHalfUp, 1000000000000000000, nil},
+		{0, EUR, 21, RoundHalfUp, 0, nil},
+		{math.MaxInt64, EUR, 3, RoundHalfUp, 0, ErrOverflow},
+		{1, EUR, -1, RoundHalfUp, 0, errors.New("negative")},
+	}
+
+	for _, tc := range tcs {
+		a, err := New(tc.amount, tc.code).Rescale(tc.fraction, tc.mode)
+		if tc.err != nil {
+			if err == nil || (tc.err == ErrOverflow && !errors.Is(err, ErrOverflow)) {
+				t.Errorf("Expected error %v for %d %s got %v", tc.err, tc.amount, tc.code, err)
+			}
+
+			continue
+		}
+
+		if err != nil || a != tc.expected {
+			t.Errorf("Expected %d for %d %s at %d got %d %v", tc.expected, tc.amount, tc.code, tc.fraction, a, err)
+		}
+	}
+}
+
+func TestNewWithFraction(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		fraction int
+		code     string
+		mode     RoundingMode
+		expected int64
+		err      error
+	}{
+		{10000, 2, JPY, RoundHalfUp, 100, nil},
+		{10050, 2, JPY, RoundHalfEven, 100, nil},
+		{10050, 2, JPY, RoundHalfUp, 101, nil},
+		{123, 0, HUF, RoundHalfUp, 12300, nil},
+		{123, 2, EUR, RoundHalfUp, 123, nil},
+		{math.MaxInt64, 0, EUR, RoundHalfUp, 0, ErrOverflow},
+		{1, -2, EUR, RoundHalfUp, 0, errors.New("negative")},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewWithFraction(tc.amount, tc.fraction, tc.code, tc.mode)
+		if tc.err != nil {
+			if err == nil || (tc.err == ErrOverflow && !errors.Is(err, ErrOverflow)) {
+				t.Errorf("Expected error %v for %d got %v", tc.err, tc.amount, err)
+			}
+
+			continue
+		}
+
+		if err != nil || m.Amount() != tc.expected || m.Currency().Code != tc.code {
+			t.Errorf("Expected %d %s got %v %v", tc.expected, tc.code, m, err)
+		}
+	}
+}
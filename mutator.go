@@ -1,8 +1,9 @@
 package money
 
 type mutator struct {
-	calc *calculator
+	calc    *calculator
+	bigCalc *bigCalculator
 }
 
 // initialize our default mutator here.
-var mutate = mutator{calc: &calculator{}}
+var mutate = mutator{calc: &calculator{}, bigCalc: &bigCalculator{}}
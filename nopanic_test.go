@@ -0,0 +1,234 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// maxParties bounds the number of parties the exercisers split into, as Split allocates one per party.
+const maxParties = 1 << 16
+
+// exerciseMoney calls every exported function taking Money with the given arguments.
+// It fails the test by panicking, so the arguments are what a panic has to be reproduced with.
+func exerciseMoney(m, om *Money, i int64, n int, mode RoundingMode, s string) {
+	ms := []*Money{m, om}
+	r := big.NewRat(i, int64(n)|1)
+
+	m.Currency()
+	m.Amount()
+	m.SameCurrency(om)
+	_, _ = m.Equals(om)
+	_, _ = m.GreaterThan(om)
+	_, _ = m.GreaterThanOrEqual(om)
+	_, _ = m.LessThan(om)
+	_, _ = m.LessThanOrEqual(om)
+	_, _ = m.Compare(om)
+	_, _ = m.CompareWith(om, nil)
+	_, _ = m.CompareWith(om, ExchangeRates{EUR: r, USD: nil})
+	m.IsZero()
+	m.IsPositive()
+	m.IsNegative()
+	m.Absolute()
+	m.Negative()
+	m.Sign()
+	_, _ = m.CopySign(om)
+	_, _ = m.Min(om)
+	_, _ = m.Max(om)
+	_, _ = m.Clamp(om, m)
+	_, _ = m.IsBetween(om, m)
+	_, _ = m.Add(ms...)
+	_, _ = m.Subtract(ms...)
+	m.Multiply()
+	m.Multiply(i, int64(n))
+	_, _ = m.MultiplyE()
+	_, _ = m.MultiplyE(i, int64(n))
+	_, _ = m.MultiplyRat(r, mode)
+	_, _ = m.MultiplyRat(nil, mode)
+	_, _ = m.MultiplyDecimal(s, mode)
+	_, _ = m.Percent(s, mode)
+	m.Round()
+	_, _ = m.RoundWithMode(mode)
+	_, _ = m.RoundToIncrement(i, mode)
+	_, _ = m.RoundToCash(mode)
+	_, _ = m.RoundToAccounting(mode)
+	_, _ = m.Rescale(n, mode)
+	_, _ = m.Divide(i, mode)
+	_, _, _ = m.DivMod(i)
+	m.Display()
+	m.AsMajorUnits()
+	m.Big()
+	_, _ = m.Value()
+
+	_, _ = m.Split(n % maxParties)
+	_, _ = m.SplitWithStrategy(n%maxParties, LargestRemainder{})
+	_, _ = m.SplitWithStrategy(n%maxParties, nil)
+	_, _ = m.Allocate(n, int(i))
+	_, _ = m.AllocateWithStrategy(SeededRandom{Seed: i}, n, int(i), 1)
+	_, _ = m.AllocateWithStrategy(nil, n)
+	_, _ = m.AllocateRat(r, nil)
+	_, _ = m.AllocateRat(r, big.NewRat(1, 1))
+	_, _ = m.AllocateDecimal(s, "1")
+	_, _ = m.AllocateRatWithStrategy(LargestRemainder{}, r, big.NewRat(1, 3))
+	_, _ = m.AllocateDecimalWithStrategy(nil, s)
+	_, _ = m.AllocateByPlan(AllocationPlan{FixedParty(om), RatioParty(n), BoundedParty(int(i), om, m), BoundedParty(1, nil, nil)})
+	_, _ = m.AllocateByPlan(AllocationPlan{BoundedParty(n, nil, om)})
+
+	_, _ = NewStrict(i, s)
+	_, _ = Parse(s, codeOf(m.Currency()))
+	_, _ = (*Registry)(nil).Parse(s, s)
+	_, _ = NewFromNumericCode(i, s)
+	_ = (*Registry)(nil).CurrencyByNumericCode(s)
+	_, _ = ParseBig(s, codeOf(m.Currency()))
+	_, _ = (*Registry)(nil).ParseBigUnit(s, s, s)
+	m.Currency().Unit(s)
+	m.Currency().Exponent(s)
+	_, _ = NewWithFraction(i, n, s, mode)
+
+	_, _ = Sum(ms...)
+	_, _ = Min(ms...)
+	_, _ = Max(ms...)
+	_, _ = Average(mode, ms...)
+	_, _ = Median(mode, ms...)
+	_ = SortAscending(ms)
+	_ = SortDescending(ms)
+	_ = SortAscendingWith(ms, ExchangeRates{EUR: r}, s)
+	_ = SortDescendingWith(ms, ConverterFunc(nil), s)
+	_ = SortAscendingWith(ms, nil, s)
+	_, _ = ExchangeRates{EUR: r, USD: big.NewRat(1, 1)}.Convert(m, s)
+
+	// Other tests replace the JSON injection points, so the defaults are called directly.
+	if m != nil {
+		_, _ = defaultMarshalJSON(*m)
+	}
+
+	_ = defaultUnmarshalJSON(&Money{}, []byte(s))
+	_ = (&Money{}).Scan(s)
+	_ = (&Money{}).Scan(i)
+	_ = (*Money)(nil).UnmarshalJSON([]byte(s))
+	_ = (*Money)(nil).Scan(s)
+}
+
+// exerciseBigMoney calls every exported method of BigMoney with the given arguments.
+func exerciseBigMoney(m, om *BigMoney, i int64, n int, mode RoundingMode, s string) {
+	r := big.NewRat(i, int64(n)|1)
+
+	m.Currency()
+	m.Amount()
+	_, _ = m.Money()
+	m.SameCurrency(om)
+	_, _ = m.Equals(om)
+	_, _ = m.GreaterThan(om)
+	_, _ = m.GreaterThanOrEqual(om)
+	_, _ = m.LessThan(om)
+	_, _ = m.LessThanOrEqual(om)
+	_, _ = m.Compare(om)
+	m.IsZero()
+	m.IsPositive()
+	m.IsNegative()
+	m.Absolute()
+	m.Negative()
+	_, _ = m.Add(om, m)
+	_, _ = m.Subtract(om, m)
+	m.Multiply()
+	m.Multiply(i, int64(n))
+	_, _ = m.MultiplyRat(r, mode)
+	_, _ = m.MultiplyRat(nil, mode)
+	_, _ = m.MultiplyDecimal(s, mode)
+	m.Round()
+	_, _ = m.RoundWithMode(mode)
+	_, _ = m.Divide(i, mode)
+	_, _, _ = m.DivMod(i)
+	m.Display()
+	m.AsMajorUnits()
+	m.AsMajorUnitsRat()
+	_, _ = m.In(s)
+	_, _ = m.DisplayIn(s)
+	_, _ = m.Value()
+
+	_, _ = m.Split(n % maxParties)
+	_, _ = m.SplitWithStrategy(n%maxParties, nil)
+	_, _ = m.Allocate(n, int(i))
+	_, _ = m.AllocateWithStrategy(LargestRatio{}, n, int(i), 1)
+
+	if m != nil {
+		_, _ = json.Marshal(m)
+	}
+
+	_ = (&BigMoney{}).UnmarshalJSON([]byte(s))
+	_ = (&BigMoney{}).Scan(s)
+	_ = (*BigMoney)(nil).UnmarshalJSON([]byte(s))
+	_ = (*BigMoney)(nil).Scan(s)
+}
+
+func TestMoney_NilReceiver(t *testing.T) {
+	var m *Money
+	exerciseMoney(m, nil, 0, 0, RoundHalfUp, "")
+	exerciseMoney(m, New(100, EUR), 3, 2, RoundHalfEven, "1.5")
+	exerciseMoney(New(100, EUR), m, -3, 2, RoundingMode(-1), "x")
+	exerciseMoney(&Money{}, &Money{}, 1, 1, RoundDown, "10|EUR")
+	exerciseMoney(New(1, EUR), New(2, EUR), math.MaxInt64, math.MaxInt, RoundHalfUp, "1")
+	exerciseMoney(New(1, EUR), New(2, EUR), math.MinInt64, math.MinInt, RoundHalfUp, "1")
+
+	// Registries reject such fractions, they're put directly to check that nothing panics anyway.
+	huge := newRegistry(Currencies{})
+	huge.put(huge.bind(&Currency{Code: "HUGE", Fraction: math.MaxInt}))
+	huge.put(huge.bind(&Currency{Code: "TINY", Fraction: math.MinInt}))
+	exerciseMoney(huge.New(1, "HUGE"), huge.New(1, "TINY"), 1, math.MaxInt, RoundHalfUp, "1.5")
+	exerciseMoney(huge.New(1, "TINY"), huge.New(1, "HUGE"), 1, 3, RoundHalfUp, "TINY")
+}
+
+func TestBigMoney_NilReceiver(t *testing.T) {
+	var m *BigMoney
+	exerciseBigMoney(m, nil, 0, 0, RoundHalfUp, "")
+	exerciseBigMoney(m, NewBig(big.NewInt(100), EUR), 3, 2, RoundHalfEven, "1.5")
+	exerciseBigMoney(NewBig(nil, EUR), m, -3, 2, RoundingMode(-1), "x")
+	exerciseBigMoney(&BigMoney{}, &BigMoney{}, 1, 1, RoundDown, "10|EUR")
+	exerciseBigMoney(NewBig(big.NewInt(1), EUR), nil, math.MaxInt64, math.MaxInt, RoundHalfUp, "1")
+	exerciseBigMoney(NewBig(big.NewInt(1), EUR), nil, math.MinInt64, math.MinInt, RoundHalfUp, "1")
+}
+
+func TestRegistry_ZeroValueNoPanic(t *testing.T) {
+	var r Registry
+	r.Add(&Currency{Code: "ABC", NumericCode: "900"}).Add(nil).Add(&Currency{Code: "ABD", NumericCode: "900"})
+	_ = r.Register(&Currency{Code: "DEF", NumericCode: "901"})
+	_ = r.Register(&Currency{Code: "GHI", NumericCode: "900"})
+	_ = r.Register(nil)
+	r.AddCurrency("JKL", "J", "$1", ".", ",", 2)
+	_ = r.CurrencyByNumericCode("900")
+	_ = r.CurrencyByCode("ABC")
+	_ = r.Clone().SetStrict(true)
+	_, _ = r.NewFromNumericCode(1, "900")
+	_, _ = r.Parse("1", "ABC")
+	_, _ = r.ParseJSON([]byte(`{"amount":1,"currency":"ABC"}`))
+	_ = r.CurrenciesForCountry("AA")
+	r.AddCrypto()
+
+	var m Registry
+	exerciseMoney(m.New(1, "ABC"), m.New(2, "ABC"), 3, 2, RoundHalfUp, "1")
+}
+
+func TestCurrency_NilReceiver(t *testing.T) {
+	var c *Currency
+	c.Formatter().Format(100)
+	_ = c.Scan(EUR)
+	_ = (&Currency{}).Scan(int64(1))
+
+	var f *Formatter
+	f.Format(-100)
+	f.FormatBig(nil)
+	f.ToMajorUnits(100)
+	f.ToMajorUnitsBig(nil)
+	f.ToMajorUnitsRat(nil)
+	NewFormatter(-2, ".", ",", "$", "1$").Format(123456)
+	NewFormatter(math.MaxInt, ".", ",", "$", "1$").Format(1)
+	(&Formatter{Fraction: math.MaxInt}).FormatBig(big.NewInt(1))
+	(&Formatter{Fraction: math.MaxInt}).ToMajorUnitsRat(big.NewInt(1))
+	(&Formatter{Fraction: math.MaxInt}).ToMajorUnits(1)
+
+	var cs Currencies
+	cs.CurrencyByCode(EUR)
+	cs.CurrencyByNumericCode("978")
+	cs.Add(nil).Add(&Currency{Code: EUR})
+}
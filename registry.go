@@ -0,0 +1,475 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Registry is a set of currencies which is safe for concurrent use.
+//
+// Money created by a Registry is bound to it: formatting and rounding use the currencies of
+// that Registry, so tests and tenants can have isolated currency sets. Package level functions
+// such as New and AddCurrency use the default Registry.
+type Registry struct {
+	mu         sync.RWMutex
+	currencies Currencies
+	byNumeric  map[string]*Currency
+	strict     bool
+}
+
+var defaultRegistry = newRegistry(currencies)
+
+// newRegistry returns new Registry using the given currencies list, which must already belong to it.
+func newRegistry(cs Currencies) *Registry {
+	r := &Registry{currencies: cs, byNumeric: make(map[string]*Currency, len(cs))}
+	for _, c := range cs {
+		if c != nil && c.NumericCode != "" {
+			r.byNumeric[normalizeNumericCode(c.NumericCode)] = c
+		}
+	}
+
+	return r
+}
+
+// DefaultRegistry returns the Registry used by New, AddCurrency and GetCurrency.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry creates and returns new Registry holding copies of the given currencies.
+// Use DefaultRegistry().Clone() for a Registry starting with the built-in currencies.
+func NewRegistry(cs ...*Currency) *Registry {
+	r := newRegistry(Currencies{})
+	for _, c := range cs {
+		r.Add(c)
+	}
+
+	return r
+}
+
+func (r *Registry) orDefault() *Registry {
+	if r == nil {
+		return defaultRegistry
+	}
+
+	return r
+}
+
+// bind returns a copy of c which belongs to the Registry.
+func (r *Registry) bind(c *Currency) *Currency {
+	bc := *c
+	bc.meta = c.meta.clone()
+	bc.registry = nil
+	if r != defaultRegistry {
+		bc.registry = r
+	}
+
+	return &bc
+}
+
+// fractions are the fractions of a currency.
+type fractions struct {
+	fraction, accounting, cash int
+}
+
+// builtinFractions are the fractions of the built-in currencies, by code.
+var builtinFractions = func() map[string]fractions {
+	fs := make(map[string]fractions, len(currencies))
+	for code, c := range currencies {
+		fs[code] = fractions{c.Fraction, c.AccountingFraction, c.CashFraction}
+	}
+
+	return fs
+}()
+
+// withFractions sets the accounting and cash fractions of c when they're unset, see Currency.AccountingFraction.
+func withFractions(c *Currency) *Currency {
+	if c.AccountingFraction != 0 || c.CashFraction != 0 || c.Fraction == 0 {
+		return c
+	}
+
+	c.AccountingFraction, c.CashFraction = c.Fraction, c.Fraction
+	if fs, ok := builtinFractions[c.Code]; ok && fs.fraction == c.Fraction {
+		c.AccountingFraction, c.CashFraction = fs.accounting, fs.cash
+	}
+
+	return c
+}
+
+// Clone returns new Registry holding copies of the currencies of the Registry.
+// Changes to either Registry don't affect the other.
+func (r *Registry) Clone() *Registry {
+	r = r.orDefault()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cr := newRegistry(make(Currencies, len(r.currencies)))
+	for _, c := range r.currencies {
+		if c != nil {
+			cr.put(cr.bind(c))
+		}
+	}
+
+	return cr
+}
+
+// put inserts or updates the currency, which must belong to the Registry, and keeps the numeric code index.
+// The write lock must be held.
+func (r *Registry) put(c *Currency) {
+	if old := r.currencies[c.Code]; old != nil && r.byNumeric[normalizeNumericCode(old.NumericCode)] == old {
+		delete(r.byNumeric, normalizeNumericCode(old.NumericCode))
+	}
+
+	r.currencies = r.currencies.Add(c)
+	if numeric := normalizeNumericCode(c.NumericCode); numeric != "" {
+		// The zero value Registry has no index yet.
+		if r.byNumeric == nil {
+			r.byNumeric = make(map[string]*Currency)
+		}
+
+		r.byNumeric[numeric] = c
+	}
+}
+
+// numericCodeOwner returns the other currency using the numeric code of c, nil if there is none.
+// The lock must be held.
+func (r *Registry) numericCodeOwner(c *Currency) *Currency {
+	numeric := normalizeNumericCode(c.NumericCode)
+	if other := r.byNumeric[numeric]; numeric != "" && other != nil && other.Code != c.Code {
+		return other
+	}
+
+	return nil
+}
+
+// Add inserts or updates a copy of the given Currency and returns the Registry.
+// A nil currency, one whose numeric code is used by another currency, or one with a fraction
+// or exponent out of range, is ignored, use Register to get an error instead.
+// Unset accounting and cash fractions are set, see Currency.AccountingFraction.
+func (r *Registry) Add(currency *Currency) *Registry {
+	r = r.orDefault()
+	if currency == nil {
+		return r
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c := withFractions(r.bind(currency)); validFractions(c) == nil && r.numericCodeOwner(c) == nil {
+		r.put(c)
+	}
+
+	return r
+}
+
+// Register inserts or updates a copy of the given Currency. Unlike Add it validates the currency:
+// the code can't be empty, the fractions and exponents must be between 0 and MaxFraction,
+// or ErrInvalidFraction is returned, and the numeric code must be made of 3 digits and not be used by
+// another currency, or ErrDuplicateNumericCode is returned.
+func (r *Registry) Register(currency *Currency) error {
+	if currency == nil || currency.Code == "" {
+		return errors.New("can't register a currency without a code")
+	}
+
+	if currency.NumericCode != "" && normalizeNumericCode(currency.NumericCode) != currency.NumericCode {
+		return fmt.Errorf("invalid numeric code %q for %s", currency.NumericCode, currency.Code)
+	}
+
+	r = r.orDefault()
+	c := withFractions(r.bind(currency))
+	if err := validFractions(c); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if other := r.numericCodeOwner(c); other != nil {
+		return fmt.Errorf("%w: %s is used by %s", ErrDuplicateNumericCode, c.NumericCode, other.Code)
+	}
+
+	r.put(c)
+	return nil
+}
+
+// AddCurrency lets you insert or update currency in the Registry.
+// It can't set a numeric code, so it can't take one used by another currency,
+// use Register for currencies having one. A Fraction out of range, see MaxFraction,
+// isn't added and nil is returned.
+func (r *Registry) AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int) *Currency {
+	c := &Currency{
+		Code:     code,
+		Grapheme: Grapheme,
+		Template: Template,
+		Decimal:  Decimal,
+		Thousand: Thousand,
+		Fraction: Fraction,
+	}
+
+	r = r.orDefault()
+	c = withFractions(r.bind(c))
+	if validFractions(c) != nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(c)
+	return c
+}
+
+// SetStrict sets whether unmarshalling Money from JSON rejects currency codes which aren't registered
+// with ErrUnknownCurrency, and returns the Registry. It applies to Money unmarshalled with ParseJSON
+// or into Money bound to the Registry, the zero value Money uses the default Registry.
+// Scanning from a database always rejects unknown codes, and New never does as it can't return an error,
+// use NewStrict instead.
+func (r *Registry) SetStrict(strict bool) *Registry {
+	r = r.orDefault()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.strict = strict
+	return r
+}
+
+// Strict reports whether the Registry rejects unknown currency codes, see SetStrict.
+func (r *Registry) Strict() bool {
+	r = r.orDefault()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.strict
+}
+
+// ParseJSON unmarshals Money from JSON using the currencies of the Registry. It returns a ParseError
+// matching ErrUnknownCurrency for a currency code which isn't registered when the Registry is strict.
+func (r *Registry) ParseJSON(b []byte) (*Money, error) {
+	m := &Money{}
+	if err := unmarshalJSON(r, m, b); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// assertKnown returns ErrUnknownCurrency when the Registry is strict and the code isn't registered.
+func (r *Registry) assertKnown(code string) error {
+	if !r.Strict() {
+		return nil
+	}
+
+	if r.GetCurrency(code) == nil {
+		return ErrUnknownCurrency
+	}
+
+	return nil
+}
+
+// CurrencyByCode returns the currency given the currency code defined as a constant.
+func (r *Registry) CurrencyByCode(code string) *Currency {
+	r = r.orDefault()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.currencies.CurrencyByCode(code)
+}
+
+// CurrencyByNumericCode returns the currency given the numeric code defined in ISO-4271.
+// Leading zeros can be left out, e.g. "36" finds AUD like "036".
+func (r *Registry) CurrencyByNumericCode(code string) *Currency {
+	r = r.orDefault()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.byNumeric[normalizeNumericCode(code)]
+}
+
+// normalizeNumericCode pads a numeric code of up to 3 digits with leading zeros.
+// Anything else is returned unchanged.
+func normalizeNumericCode(code string) string {
+	if len(code) == 0 || len(code) > 3 || strings.Trim(code, "0123456789") != "" {
+		return code
+	}
+
+	return strings.Repeat("0", 3-len(code)) + code
+}
+
+// GetCurrency returns the currency given the code.
+func (r *Registry) GetCurrency(code string) *Currency {
+	return r.CurrencyByCode(strings.ToUpper(code))
+}
+
+// GetCurrencyAt returns the currency given the code if it's in use at the given date, see Currency.ActiveAt.
+func (r *Registry) GetCurrencyAt(code string, date time.Time) *Currency {
+	if c := r.GetCurrency(code); c.ActiveAt(date) {
+		return c
+	}
+
+	return nil
+}
+
+// ActiveAt returns the currencies of the Registry in use at the given date, see Currency.ActiveAt.
+func (r *Registry) ActiveAt(date time.Time) Currencies {
+	r = r.orDefault()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cs := make(Currencies)
+	for code, c := range r.currencies {
+		if c.ActiveAt(date) {
+			cs[code] = c
+		}
+	}
+
+	return cs
+}
+
+// CurrenciesForCountry returns the currencies of the Registry in use in the country
+// given its ISO 3166 alpha-2 code, sorted by currency code.
+func (r *Registry) CurrenciesForCountry(country string) []*Currency {
+	return r.CountryCurrencies()[strings.ToUpper(country)]
+}
+
+// CountryCurrencies returns the currencies of the Registry in use in every country,
+// by ISO 3166 alpha-2 code and sorted by currency code.
+func (r *Registry) CountryCurrencies() map[string][]*Currency {
+	byCountry := make(map[string][]*Currency)
+	for _, c := range r.ActiveAt(time.Now()) {
+		for _, country := range c.Countries() {
+			byCountry[country] = append(byCountry[country], c)
+		}
+	}
+
+	for _, cs := range byCountry {
+		sort.Slice(cs, func(i, j int) bool {
+			return cs[i].Code < cs[j].Code
+		})
+	}
+
+	return byCountry
+}
+
+// Currencies returns a copy of the currencies list of the Registry.
+func (r *Registry) Currencies() Currencies {
+	r = r.orDefault()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cs := make(Currencies, len(r.currencies))
+	for code, c := range r.currencies {
+		cs[code] = c
+	}
+
+	return cs
+}
+
+// New creates and returns new instance of Money bound to the Registry.
+func (r *Registry) New(amount int64, code string) *Money {
+	return &Money{
+		amount:   amount,
+		currency: r.get(strings.ToUpper(code)),
+	}
+}
+
+// NewStrict creates and returns new instance of Money bound to the Registry.
+// Unlike New it returns ErrUnknownCurrency when the currency code isn't registered,
+// and ErrWithdrawnCurrency when the currency is no longer in use.
+func (r *Registry) NewStrict(amount int64, code string) (*Money, error) {
+	c, err := r.current(code, "NewStrict")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// Parse creates and returns new instance of Money bound to the Registry from a decimal amount
+// in major units, e.g. Parse("12.34", EUR). It returns ErrUnknownCurrency when the currency code
+// isn't registered, ErrWithdrawnCurrency when the currency is no longer in use, and an error
+// when the amount has more decimals than the currency.
+func (r *Registry) Parse(amount, code string) (*Money, error) {
+	c, err := r.current(code, "Parse")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := parseDecimal(amount)
+	if err != nil {
+		return nil, &ParseError{Op: "Parse", Input: amount, Err: err}
+	}
+
+	p, err := pow10Big(c.Fraction)
+	if err != nil {
+		return nil, &ParseError{Op: "Parse", Input: amount, Err: err}
+	}
+
+	d.Mul(d, new(big.Rat).SetInt(p))
+	if !d.IsInt() {
+		return nil, &ParseError{Op: "Parse", Input: amount, Err: fmt.Errorf("more than %d decimals for %s", c.Fraction, c.Code)}
+	}
+
+	if !d.Num().IsInt64() {
+		return nil, &ParseError{Op: "Parse", Input: amount, Err: ErrOverflow}
+	}
+
+	return &Money{amount: d.Num().Int64(), currency: c}, nil
+}
+
+// NewFromNumericCode creates and returns new instance of Money bound to the Registry given the
+// ISO 4217 numeric code of its currency, as carried by card networks and ISO 8583 messages.
+// It returns ErrUnknownCurrency when no currency has the numeric code, and ErrWithdrawnCurrency
+// when the currency is no longer in use.
+func (r *Registry) NewFromNumericCode(amount int64, numericCode string) (*Money, error) {
+	c := r.CurrencyByNumericCode(numericCode)
+	if c == nil {
+		return nil, &ParseError{Op: "NewFromNumericCode", Input: numericCode, Err: ErrUnknownCurrency}
+	}
+
+	if c.withdrawnAt(time.Now()) {
+		return nil, &ParseError{Op: "NewFromNumericCode", Input: numericCode, Err: ErrWithdrawnCurrency}
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// current returns the registered currency of the code, which must still be in use.
+// Historical records are read with Scan, which accepts withdrawn currencies.
+func (r *Registry) current(code, op string) (*Currency, error) {
+	c := r.GetCurrency(code)
+	if c == nil {
+		return nil, &ParseError{Op: op, Input: code, Err: ErrUnknownCurrency}
+	}
+
+	if c.withdrawnAt(time.Now()) {
+		return nil, &ParseError{Op: op, Input: code, Err: ErrWithdrawnCurrency}
+	}
+
+	return c, nil
+}
+
+// NewBig creates and returns new BigMoney bound to the Registry. A nil amount is treated as zero.
+func (r *Registry) NewBig(amount *big.Int, code string) *BigMoney {
+	m := NewBig(amount, code)
+	m.currency = r.get(strings.ToUpper(code))
+	return m
+}
+
+// get returns the currency registered for the code, or the default currency of the Registry.
+func (r *Registry) get(code string) *Currency {
+	r = r.orDefault()
+	r.mu.RLock()
+	c, ok := r.currencies[code]
+	r.mu.RUnlock()
+
+	if ok && c != nil {
+		return c
+	}
+
+	return r.bind(&Currency{Code: code}).getDefault()
+}
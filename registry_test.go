@@ -0,0 +1,315 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestRegistry_Isolated(t *testing.T) {
+	r := NewRegistry(&Currency{Code: "ABC", Fraction: 3, Grapheme: "A$", Template: "$1", Decimal: ".", Thousand: ","})
+
+	m := r.New(12345, "abc")
+	if m.Display() != "A$12.345" {
+		t.Errorf("Expected %s got %s", "A$12.345", m.Display())
+	}
+
+	if GetCurrency("ABC") != nil {
+		t.Error("Expected ABC not to be added to the default registry")
+	}
+
+	if New(12345, "ABC").Display() != "123.45ABC" {
+		t.Errorf("Expected %s got %s", "123.45ABC", New(12345, "ABC").Display())
+	}
+
+	// Currencies unknown to the registry get the default currency, even if the default registry knows them.
+	if r.New(100, EUR).Display() != "1.00EUR" {
+		t.Errorf("Expected %s got %s", "1.00EUR", r.New(100, EUR).Display())
+	}
+
+	rounded, err := m.RoundWithMode(RoundHalfUp)
+	if err != nil || rounded.Amount() != 12000 {
+		t.Errorf("Expected %d got %d, %v", 12000, rounded.Amount(), err)
+	}
+
+	if r.NewBig(nil, "ABC").Currency().Fraction != 3 {
+		t.Errorf("Expected fraction %d got %d", 3, r.NewBig(nil, "ABC").Currency().Fraction)
+	}
+}
+
+func TestRegistry_Clone(t *testing.T) {
+	r := DefaultRegistry().Clone()
+	r.AddCurrency(EUR, "€", "1 $", ",", ".", 3)
+
+	if r.New(123456, EUR).Display() != "123,456 €" {
+		t.Errorf("Expected %s got %s", "123,456 €", r.New(123456, EUR).Display())
+	}
+
+	if New(123456, EUR).Display() != "€1,234.56" {
+		t.Errorf("Expected %s got %s", "€1,234.56", New(123456, EUR).Display())
+	}
+
+	if r.GetCurrency("usd") == nil || r.CurrencyByNumericCode("840") == nil {
+		t.Error("Expected a clone to have the currencies of the default registry")
+	}
+
+	if len(r.Currencies()) != len(DefaultRegistry().Currencies()) {
+		t.Errorf("Expected %d currencies got %d", len(DefaultRegistry().Currencies()), len(r.Currencies()))
+	}
+}
+
+func TestRegistry_Convert(t *testing.T) {
+	r := NewRegistry(&Currency{Code: "ABC", Fraction: 3}, &Currency{Code: "DEF", Fraction: 0})
+
+	m, err := ExchangeRates{"ABC": big.NewRat(1, 1), "DEF": big.NewRat(1, 1)}.Convert(r.New(2000, "ABC"), "DEF")
+	if err != nil || m.Amount() != 2 || m.Currency().Code != "DEF" {
+		t.Errorf("Expected 2 DEF got %v, %v", m, err)
+	}
+}
+
+func TestRegistry_Nil(t *testing.T) {
+	var r *Registry
+	r.Add(nil)
+
+	if r.New(100, EUR).Display() != "€1.00" {
+		t.Errorf("Expected %s got %s", "€1.00", r.New(100, EUR).Display())
+	}
+
+	if r.CurrencyByCode(EUR) == nil || r.Clone() == nil {
+		t.Error("Expected a nil registry to be the default registry")
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	r := DefaultRegistry().Clone()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+			r.AddCurrency(fmt.Sprintf("C%02d", i), "$", "$1", ".", ",", 2)
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			r.New(100, fmt.Sprintf("C%02d", i)).Display()
+			r.CurrencyByNumericCode("978")
+		}(i)
+	}
+
+	wg.Wait()
+
+	if len(r.Currencies()) != len(DefaultRegistry().Currencies())+8 {
+		t.Errorf("Expected 8 more currencies got %d", len(r.Currencies()))
+	}
+}
+
+func TestRegistry_CurrencyByNumericCode(t *testing.T) {
+	r := DefaultRegistry().Clone()
+
+	tcs := []struct {
+		code     string
+		expected string
+	}{
+		{"978", EUR},
+		{"036", AUD},
+		{"36", AUD},
+		{"8", ALL},
+		{"999", ""},
+		{"", ""},
+		{"0036", ""},
+		{"abc", ""},
+	}
+
+	for _, tc := range tcs {
+		c := r.CurrencyByNumericCode(tc.code)
+		if codeOf(c) != tc.expected {
+			t.Errorf("Expected %q for %q got %q", tc.expected, tc.code, codeOf(c))
+		}
+	}
+
+	// Replacing a currency moves its numeric code.
+	r.Add(&Currency{Code: EUR, NumericCode: "900", Fraction: 2})
+	if r.CurrencyByNumericCode("978") != nil {
+		t.Error("Expected the old numeric code of EUR to be removed")
+	}
+
+	if c := r.CurrencyByNumericCode("900"); codeOf(c) != EUR {
+		t.Errorf("Expected EUR for 900 got %q", codeOf(c))
+	}
+
+	if c := DefaultRegistry().CurrencyByNumericCode("978"); codeOf(c) != EUR {
+		t.Errorf("Expected the default registry to be untouched got %q", codeOf(c))
+	}
+
+	// A numeric code used by another currency is rejected, the other currency is left as is.
+	r.Add(&Currency{Code: "ABC", NumericCode: "840", Fraction: 2})
+	r.Add(&Currency{Code: "ABD", NumericCode: "36", Fraction: 2})
+	if c := r.CurrencyByNumericCode("840"); codeOf(c) != USD || c.NumericCode != "840" {
+		t.Errorf("Expected USD for 840 got %q", codeOf(c))
+	}
+
+	if c := r.CurrencyByNumericCode("036"); codeOf(c) != AUD {
+		t.Errorf("Expected AUD for 036 got %q", codeOf(c))
+	}
+
+	if r.CurrencyByCode("ABC") != nil || r.CurrencyByCode("ABD") != nil {
+		t.Error("Expected currencies with a used numeric code not to be added")
+	}
+
+	if err := r.Register(&Currency{Code: "ABC", NumericCode: "840"}); !errors.Is(err, ErrDuplicateNumericCode) {
+		t.Errorf("Expected %v got %v", ErrDuplicateNumericCode, err)
+	}
+
+	// Numeric codes added without leading zeros are found either way.
+	r.Add(&Currency{Code: "DEF", NumericCode: "7", Fraction: 2})
+	if c := r.CurrencyByNumericCode("007"); codeOf(c) != "DEF" {
+		t.Errorf("Expected DEF for 007 got %q", codeOf(c))
+	}
+}
+
+func TestRegistry_ZeroValue(t *testing.T) {
+	var r Registry
+	r.Add(&Currency{Code: "ABC", NumericCode: "900", Fraction: 2})
+	if err := r.Register(&Currency{Code: "DEF", NumericCode: "901", Fraction: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if codeOf(r.CurrencyByNumericCode("900")) != "ABC" || codeOf(r.CurrencyByNumericCode("901")) != "DEF" {
+		t.Errorf("Expected ABC and DEF got %v and %v", r.CurrencyByNumericCode("900"), r.CurrencyByNumericCode("901"))
+	}
+
+	if c := r.AddCurrency("GHI", "G", "$1", ".", ",", 2); r.CurrencyByCode("GHI") != c {
+		t.Errorf("Expected GHI got %v", r.CurrencyByCode("GHI"))
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := NewRegistry(&Currency{Code: "ABC", NumericCode: "900", Fraction: 2})
+
+	tcs := []struct {
+		currency *Currency
+		err      error
+	}{
+		{&Currency{Code: "DEF", NumericCode: "901", Fraction: 2}, nil},
+		{&Currency{Code: "GHI"}, nil},
+		{&Currency{Code: "ABC", NumericCode: "900", Fraction: 3}, nil},
+		{&Currency{Code: "JKL", NumericCode: "900"}, ErrDuplicateNumericCode},
+		{&Currency{Code: "JKL", NumericCode: "91"}, errors.New("invalid")},
+		{&Currency{NumericCode: "902"}, errors.New("invalid")},
+		{nil, errors.New("invalid")},
+	}
+
+	for _, tc := range tcs {
+		err := r.Register(tc.currency)
+		switch {
+		case tc.err == nil && err != nil:
+			t.Errorf("Expected no error for %v got %v", tc.currency, err)
+		case tc.err != nil && err == nil:
+			t.Errorf("Expected error for %v got nil", tc.currency)
+		case tc.err == ErrDuplicateNumericCode && !errors.Is(err, ErrDuplicateNumericCode):
+			t.Errorf("Expected ErrDuplicateNumericCode for %v got %v", tc.currency, err)
+		}
+	}
+
+	if c := r.CurrencyByNumericCode("900"); codeOf(c) != "ABC" || c.Fraction != 3 {
+		t.Errorf("Expected ABC with fraction 3 for 900 got %v", c)
+	}
+
+	if r.CurrencyByCode("JKL") != nil {
+		t.Error("Expected JKL not to be registered")
+	}
+}
+
+func TestNewFromNumericCode(t *testing.T) {
+	tcs := []struct {
+		code     string
+		expected string
+		err      error
+	}{
+		{"978", EUR, nil},
+		{"840", USD, nil},
+		{"36", AUD, nil},
+		{"191", "", ErrWithdrawnCurrency},
+		{"999", "", ErrUnknownCurrency},
+		{"", "", ErrUnknownCurrency},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromNumericCode(100, tc.code)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %q got %v", tc.err, tc.code, err)
+			continue
+		}
+
+		if tc.err != nil {
+			var pe *ParseError
+			if !errors.As(err, &pe) || pe.Op != "NewFromNumericCode" || pe.Input != tc.code {
+				t.Errorf("Expected ParseError for %q got %v", tc.code, err)
+			}
+
+			continue
+		}
+
+		if m.Amount() != 100 || m.Currency().Code != tc.expected {
+			t.Errorf("Expected 100 %s got %d %s", tc.expected, m.Amount(), m.Currency().Code)
+		}
+	}
+
+	r := NewRegistry(&Currency{Code: "ABC", NumericCode: "900", Fraction: 3, Template: "$1", Grapheme: "A", Decimal: "."})
+	m, err := r.NewFromNumericCode(1234, "900")
+	if err != nil || m.Display() != "A1.234" {
+		t.Errorf("Expected A1.234 got %v %v", m, err)
+	}
+}
+
+func TestRegistry_Strict(t *testing.T) {
+	r := NewRegistry(&Currency{Code: "ABC", Fraction: 2, Decimal: ".", Template: "1$"}).SetStrict(true)
+	if !r.Strict() || DefaultRegistry().Strict() {
+		t.Fatal("Expected only the isolated registry to be strict")
+	}
+
+	unknown := []byte(`{"amount": 100, "currency": "EUR"}`)
+	if _, err := r.ParseJSON(unknown); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+
+	bound := r.New(0, "ABC")
+	if err := defaultUnmarshalJSON(bound, unknown); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v unmarshalling into bound Money got %v", ErrUnknownCurrency, err)
+	}
+
+	bigBound := r.NewBig(nil, "ABC")
+	if err := bigBound.UnmarshalJSON(unknown); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v unmarshalling into bound BigMoney got %v", ErrUnknownCurrency, err)
+	}
+
+	m, err := r.ParseJSON([]byte(`{"amount": 100, "currency": "abc"}`))
+	if err != nil || m.Currency().Code != "ABC" || m.Currency().registry != r {
+		t.Errorf("Expected 100 ABC of the registry got %v %v", m, err)
+	}
+
+	if err := defaultUnmarshalJSON(&Money{}, unknown); err != nil {
+		t.Errorf("Expected the zero value Money to use the default registry got %v", err)
+	}
+
+	if err := bound.Scan("100|EUR"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected scanning a currency unknown to the registry to fail got %v", err)
+	}
+
+	if err := bound.Scan("100|ABC"); err != nil || bound.Currency().registry != r {
+		t.Errorf("Expected scanning into bound Money to use the registry got %v", err)
+	}
+
+	if r.SetStrict(false); r.Strict() {
+		t.Error("Expected the registry not to be strict any more")
+	}
+
+	if _, err := r.ParseJSON(unknown); err != nil {
+		t.Errorf("Expected no error when not strict got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package money
+
+import "strconv"
+
+// RoundingMode specifies how a value that falls between two representable amounts is rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest neighbour, ties are rounded away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest neighbour, ties are rounded toward zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbour, ties are rounded to the even neighbour (banker's rounding).
+	RoundHalfEven
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds toward zero, which is the same as truncating.
+	RoundDown
+)
+
+var roundingModeNames = map[RoundingMode]string{
+	RoundHalfUp:   "HalfUp",
+	RoundHalfDown: "HalfDown",
+	RoundHalfEven: "HalfEven",
+	RoundCeiling:  "Ceiling",
+	RoundFloor:    "Floor",
+	RoundUp:       "Up",
+	RoundDown:     "Down",
+}
+
+// String returns the name of the rounding mode.
+func (r RoundingMode) String() string {
+	if n, ok := roundingModeNames[r]; ok {
+		return n
+	}
+
+	return "RoundingMode(" + strconv.Itoa(int(r)) + ")"
+}
+
+// away reports whether an inexact quotient truncated toward zero has to be moved one unit away from zero.
+// neg is the sign of the exact quotient, half compares the remainder with half of the divisor
+// and odd tells whether the truncated quotient is odd.
+func (r RoundingMode) away(neg bool, half int, odd bool) bool {
+	switch r {
+	case RoundUp:
+		return true
+	case RoundDown:
+		return false
+	case RoundCeiling:
+		return !neg
+	case RoundFloor:
+		return neg
+	}
+
+	switch {
+	case half > 0:
+		return true
+	case half < 0:
+		return false
+	}
+
+	return r == RoundHalfUp || (r == RoundHalfEven && odd)
+}
+
+// valid reports whether r is one of the defined rounding modes.
+func (r RoundingMode) valid() bool {
+	_, ok := roundingModeNames[r]
+	return ok
+}
@@ -0,0 +1,121 @@
+package money
+
+import (
+	"errors"
+	"sort"
+)
+
+// ByAmount implements sort.Interface for a slice of Money of the same currency, ordering it by amount.
+type ByAmount []*Money
+
+func (a ByAmount) Len() int           { return len(a) }
+func (a ByAmount) Less(i, j int) bool { return a[i].compare(a[j]) < 0 }
+func (a ByAmount) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+// SortAscending sorts Money of the same currency from the smallest to the largest amount.
+// Equal amounts keep their order. It returns ErrCurrencyMismatch for mixed currencies
+// and leaves the slice untouched.
+func SortAscending(ms []*Money) error {
+	if err := assertSortable(ms, "SortAscending"); err != nil {
+		return err
+	}
+
+	sort.Stable(ByAmount(ms))
+	return nil
+}
+
+// SortDescending sorts Money of the same currency from the largest to the smallest amount.
+// Equal amounts keep their order. It returns ErrCurrencyMismatch for mixed currencies
+// and leaves the slice untouched.
+func SortDescending(ms []*Money) error {
+	if err := assertSortable(ms, "SortDescending"); err != nil {
+		return err
+	}
+
+	sort.Stable(sort.Reverse(ByAmount(ms)))
+	return nil
+}
+
+// SortAscendingWith sorts Money of any currency from the smallest to the largest amount
+// after converting every Money into the currency code with the converter.
+func SortAscendingWith(ms []*Money, c Converter, code string) error {
+	return sortWith(ms, c, code, 1)
+}
+
+// SortDescendingWith sorts Money of any currency from the largest to the smallest amount
+// after converting every Money into the currency code with the converter.
+func SortDescendingWith(ms []*Money, c Converter, code string) error {
+	return sortWith(ms, c, code, -1)
+}
+
+// CompareWith compares Money of any currency like Compare, converting the other Money
+// into the currency of Self with the converter when the currencies differ.
+func (m *Money) CompareWith(om *Money, c Converter) (int, error) {
+	if m.SameCurrency(om) {
+		return m.compare(om), nil
+	}
+
+	if c == nil {
+		return 0, mismatch("CompareWith", m.Currency(), om.Currency())
+	}
+
+	converted, err := c.Convert(om, codeOf(m.Currency()))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := m.assertSameCurrency(converted, "CompareWith"); err != nil {
+		return 0, err
+	}
+
+	return m.compare(converted), nil
+}
+
+// sortWith sorts ms by their amount converted into code, ascending for order 1 and descending for -1.
+func sortWith(ms []*Money, c Converter, code string, order int) error {
+	if c == nil {
+		return errors.New("converter is required to sort")
+	}
+
+	converted := make([]*Money, len(ms))
+	for i, m := range ms {
+		cm, err := c.Convert(m, code)
+		if err != nil {
+			return err
+		}
+
+		converted[i] = cm
+	}
+
+	if err := assertSortable(converted, "Convert"); err != nil {
+		return err
+	}
+
+	sort.Stable(&byConverted{ms: ms, converted: converted, order: order})
+	return nil
+}
+
+// byConverted sorts Money by their converted counterparts.
+type byConverted struct {
+	ms        []*Money
+	converted []*Money
+	order     int
+}
+
+func (a *byConverted) Len() int { return len(a.ms) }
+func (a *byConverted) Less(i, j int) bool {
+	return a.converted[i].compare(a.converted[j]) == -a.order
+}
+func (a *byConverted) Swap(i, j int) {
+	a.ms[i], a.ms[j] = a.ms[j], a.ms[i]
+	a.converted[i], a.converted[j] = a.converted[j], a.converted[i]
+}
+
+// assertSortable checks that all Money share the currency, an empty slice is sortable.
+func assertSortable(ms []*Money, op string) error {
+	if len(ms) == 0 {
+		return nil
+	}
+
+	return assertAllSameCurrency(ms, op)
+}
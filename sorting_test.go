@@ -0,0 +1,169 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func moneyAmounts(ms []*Money) []int64 {
+	rs := make([]int64, 0, len(ms))
+	for _, m := range ms {
+		rs = append(rs, m.amount)
+	}
+
+	return rs
+}
+
+func TestByAmount(t *testing.T) {
+	ms := amountsToMoney(EUR, 3, -1, 2)
+	sort.Sort(ByAmount(ms))
+
+	if r := moneyAmounts(ms); !reflect.DeepEqual(r, []int64{-1, 2, 3}) {
+		t.Errorf("Expected %v got %v", []int64{-1, 2, 3}, r)
+	}
+}
+
+func TestSortAscending(t *testing.T) {
+	ms := amountsToMoney(EUR, 3, -1, 2, 2, 0)
+	first := ms[2]
+
+	if err := SortAscending(ms); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := moneyAmounts(ms); !reflect.DeepEqual(r, []int64{-1, 0, 2, 2, 3}) {
+		t.Errorf("Expected %v got %v", []int64{-1, 0, 2, 2, 3}, r)
+	}
+
+	if ms[2] != first {
+		t.Error("Expected equal amounts to keep their order")
+	}
+
+	if err := SortAscending(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSortDescending(t *testing.T) {
+	ms := amountsToMoney(EUR, 3, -1, 2, 2, 0)
+	first := ms[2]
+
+	if err := SortDescending(ms); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := moneyAmounts(ms); !reflect.DeepEqual(r, []int64{3, 2, 2, 0, -1}) {
+		t.Errorf("Expected %v got %v", []int64{3, 2, 2, 0, -1}, r)
+	}
+
+	if ms[1] != first {
+		t.Error("Expected equal amounts to keep their order")
+	}
+}
+
+func TestSort_DifferentCurrencies(t *testing.T) {
+	ms := []*Money{New(3, EUR), New(1, USD)}
+
+	if err := SortAscending(ms); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if err := SortDescending(ms); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if r := moneyAmounts(ms); !reflect.DeepEqual(r, []int64{3, 1}) {
+		t.Errorf("Expected the slice to be untouched, got %v", r)
+	}
+}
+
+func TestSortWith(t *testing.T) {
+	rates := ExchangeRates{
+		USD: big.NewRat(1, 1),
+		EUR: big.NewRat(11, 10),
+		JPY: big.NewRat(1, 150),
+	}
+
+	ms := []*Money{New(100, USD), New(100, EUR), New(100, JPY), New(95, EUR)}
+
+	if err := SortAscendingWith(ms, rates, USD); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"¥100", "$1.00", "€0.95", "€1.00"}
+	var rs []string
+	for _, m := range ms {
+		rs = append(rs, m.Display())
+	}
+
+	if !reflect.DeepEqual(rs, expected) {
+		t.Errorf("Expected %v got %v", expected, rs)
+	}
+
+	if err := SortDescendingWith(ms, rates, EUR); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []string{"€1.00", "€0.95", "$1.00", "¥100"}
+	rs = nil
+	for _, m := range ms {
+		rs = append(rs, m.Display())
+	}
+
+	if !reflect.DeepEqual(rs, expected) {
+		t.Errorf("Expected %v got %v", expected, rs)
+	}
+
+	if err := SortAscendingWith([]*Money{New(1, GBP), New(1, USD)}, rates, USD); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_CompareWith(t *testing.T) {
+	rates := ExchangeRates{
+		USD: big.NewRat(1, 1),
+		EUR: big.NewRat(11, 10),
+	}
+
+	tcs := []struct {
+		m        *Money
+		om       *Money
+		expected int
+	}{
+		{New(100, EUR), New(100, USD), 1},
+		{New(100, USD), New(100, EUR), -1},
+		{New(110, USD), New(100, EUR), 0},
+		{New(100, USD), New(50, USD), 1},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.m.CompareWith(tc.om, rates)
+		if err != nil {
+			t.Errorf("Unexpected error comparing %s with %s: %v", tc.m.Display(), tc.om.Display(), err)
+			continue
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %s compared with %s to be %d got %d", tc.m.Display(), tc.om.Display(), tc.expected, r)
+		}
+	}
+
+	if _, err := New(100, USD).CompareWith(New(100, GBP), rates); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := New(100, USD).CompareWith(New(100, EUR), nil); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	wrong := ConverterFunc(func(m *Money, code string) (*Money, error) {
+		return m, nil
+	})
+
+	if _, err := New(100, USD).CompareWith(New(100, EUR), wrong); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,5 @@
+// Package tag provides a currency tag type for every currency constant of the money package.
+// Tags are the type parameter of money.Typed, e.g. money.Typed[tag.EUR].
+package tag
+
+//go:generate go run gen.go
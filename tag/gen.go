@@ -0,0 +1,60 @@
+//go:build ignore
+
+// gen generates tags.go from the currency constants in ../constants.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strconv"
+)
+
+func main() {
+	f, err := parser.ParseFile(token.NewFileSet(), "../constants.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage tag\n\nimport money \"github.com/Rhymond/go-money\"\n")
+
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+
+		for _, s := range gd.Specs {
+			vs := s.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+
+				code, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				fmt.Fprintf(&buf, "\n// %[1]s is the tag of the %[2]s currency.\ntype %[1]s struct{}\n\n", name.Name, code)
+				fmt.Fprintf(&buf, "// Code returns %[2]q.\nfunc (%[1]s) Code() string { return money.%[1]s }\n", name.Name, code)
+			}
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("tags.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
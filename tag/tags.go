@@ -0,0 +1,1067 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package tag
+
+import money "github.com/Rhymond/go-money"
+
+// AED is the tag of the AED currency.
+type AED struct{}
+
+// Code returns "AED".
+func (AED) Code() string { return money.AED }
+
+// AFN is the tag of the AFN currency.
+type AFN struct{}
+
+// Code returns "AFN".
+func (AFN) Code() string { return money.AFN }
+
+// ALL is the tag of the ALL currency.
+type ALL struct{}
+
+// Code returns "ALL".
+func (ALL) Code() string { return money.ALL }
+
+// AMD is the tag of the AMD currency.
+type AMD struct{}
+
+// Code returns "AMD".
+func (AMD) Code() string { return money.AMD }
+
+// ANG is the tag of the ANG currency.
+type ANG struct{}
+
+// Code returns "ANG".
+func (ANG) Code() string { return money.ANG }
+
+// AOA is the tag of the AOA currency.
+type AOA struct{}
+
+// Code returns "AOA".
+func (AOA) Code() string { return money.AOA }
+
+// ARS is the tag of the ARS currency.
+type ARS struct{}
+
+// Code returns "ARS".
+func (ARS) Code() string { return money.ARS }
+
+// AUD is the tag of the AUD currency.
+type AUD struct{}
+
+// Code returns "AUD".
+func (AUD) Code() string { return money.AUD }
+
+// AWG is the tag of the AWG currency.
+type AWG struct{}
+
+// Code returns "AWG".
+func (AWG) Code() string { return money.AWG }
+
+// AZN is the tag of the AZN currency.
+type AZN struct{}
+
+// Code returns "AZN".
+func (AZN) Code() string { return money.AZN }
+
+// BAM is the tag of the BAM currency.
+type BAM struct{}
+
+// Code returns "BAM".
+func (BAM) Code() string { return money.BAM }
+
+// BBD is the tag of the BBD currency.
+type BBD struct{}
+
+// Code returns "BBD".
+func (BBD) Code() string { return money.BBD }
+
+// BDT is the tag of the BDT currency.
+type BDT struct{}
+
+// Code returns "BDT".
+func (BDT) Code() string { return money.BDT }
+
+// BGN is the tag of the BGN currency.
+type BGN struct{}
+
+// Code returns "BGN".
+func (BGN) Code() string { return money.BGN }
+
+// BHD is the tag of the BHD currency.
+type BHD struct{}
+
+// Code returns "BHD".
+func (BHD) Code() string { return money.BHD }
+
+// BIF is the tag of the BIF currency.
+type BIF struct{}
+
+// Code returns "BIF".
+func (BIF) Code() string { return money.BIF }
+
+// BMD is the tag of the BMD currency.
+type BMD struct{}
+
+// Code returns "BMD".
+func (BMD) Code() string { return money.BMD }
+
+// BND is the tag of the BND currency.
+type BND struct{}
+
+// Code returns "BND".
+func (BND) Code() string { return money.BND }
+
+// BOB is the tag of the BOB currency.
+type BOB struct{}
+
+// Code returns "BOB".
+func (BOB) Code() string { return money.BOB }
+
+// BRL is the tag of the BRL currency.
+type BRL struct{}
+
+// Code returns "BRL".
+func (BRL) Code() string { return money.BRL }
+
+// BSD is the tag of the BSD currency.
+type BSD struct{}
+
+// Code returns "BSD".
+func (BSD) Code() string { return money.BSD }
+
+// BTN is the tag of the BTN currency.
+type BTN struct{}
+
+// Code returns "BTN".
+func (BTN) Code() string { return money.BTN }
+
+// BWP is the tag of the BWP currency.
+type BWP struct{}
+
+// Code returns "BWP".
+func (BWP) Code() string { return money.BWP }
+
+// BYN is the tag of the BYN currency.
+type BYN struct{}
+
+// Code returns "BYN".
+func (BYN) Code() string { return money.BYN }
+
+// BYR is the tag of the BYR currency.
+type BYR struct{}
+
+// Code returns "BYR".
+func (BYR) Code() string { return money.BYR }
+
+// BZD is the tag of the BZD currency.
+type BZD struct{}
+
+// Code returns "BZD".
+func (BZD) Code() string { return money.BZD }
+
+// CAD is the tag of the CAD currency.
+type CAD struct{}
+
+// Code returns "CAD".
+func (CAD) Code() string { return money.CAD }
+
+// CDF is the tag of the CDF currency.
+type CDF struct{}
+
+// Code returns "CDF".
+func (CDF) Code() string { return money.CDF }
+
+// CHF is the tag of the CHF currency.
+type CHF struct{}
+
+// Code returns "CHF".
+func (CHF) Code() string { return money.CHF }
+
+// CLF is the tag of the CLF currency.
+type CLF struct{}
+
+// Code returns "CLF".
+func (CLF) Code() string { return money.CLF }
+
+// CLP is the tag of the CLP currency.
+type CLP struct{}
+
+// Code returns "CLP".
+func (CLP) Code() string { return money.CLP }
+
+// CNY is the tag of the CNY currency.
+type CNY struct{}
+
+// Code returns "CNY".
+func (CNY) Code() string { return money.CNY }
+
+// COP is the tag of the COP currency.
+type COP struct{}
+
+// Code returns "COP".
+func (COP) Code() string { return money.COP }
+
+// CRC is the tag of the CRC currency.
+type CRC struct{}
+
+// Code returns "CRC".
+func (CRC) Code() string { return money.CRC }
+
+// CUC is the tag of the CUC currency.
+type CUC struct{}
+
+// Code returns "CUC".
+func (CUC) Code() string { return money.CUC }
+
+// CUP is the tag of the CUP currency.
+type CUP struct{}
+
+// Code returns "CUP".
+func (CUP) Code() string { return money.CUP }
+
+// CVE is the tag of the CVE currency.
+type CVE struct{}
+
+// Code returns "CVE".
+func (CVE) Code() string { return money.CVE }
+
+// CZK is the tag of the CZK currency.
+type CZK struct{}
+
+// Code returns "CZK".
+func (CZK) Code() string { return money.CZK }
+
+// DJF is the tag of the DJF currency.
+type DJF struct{}
+
+// Code returns "DJF".
+func (DJF) Code() string { return money.DJF }
+
+// DKK is the tag of the DKK currency.
+type DKK struct{}
+
+// Code returns "DKK".
+func (DKK) Code() string { return money.DKK }
+
+// DOP is the tag of the DOP currency.
+type DOP struct{}
+
+// Code returns "DOP".
+func (DOP) Code() string { return money.DOP }
+
+// DZD is the tag of the DZD currency.
+type DZD struct{}
+
+// Code returns "DZD".
+func (DZD) Code() string { return money.DZD }
+
+// EEK is the tag of the EEK currency.
+type EEK struct{}
+
+// Code returns "EEK".
+func (EEK) Code() string { return money.EEK }
+
+// EGP is the tag of the EGP currency.
+type EGP struct{}
+
+// Code returns "EGP".
+func (EGP) Code() string { return money.EGP }
+
+// ERN is the tag of the ERN currency.
+type ERN struct{}
+
+// Code returns "ERN".
+func (ERN) Code() string { return money.ERN }
+
+// ETB is the tag of the ETB currency.
+type ETB struct{}
+
+// Code returns "ETB".
+func (ETB) Code() string { return money.ETB }
+
+// EUR is the tag of the EUR currency.
+type EUR struct{}
+
+// Code returns "EUR".
+func (EUR) Code() string { return money.EUR }
+
+// FJD is the tag of the FJD currency.
+type FJD struct{}
+
+// Code returns "FJD".
+func (FJD) Code() string { return money.FJD }
+
+// FKP is the tag of the FKP currency.
+type FKP struct{}
+
+// Code returns "FKP".
+func (FKP) Code() string { return money.FKP }
+
+// GBP is the tag of the GBP currency.
+type GBP struct{}
+
+// Code returns "GBP".
+func (GBP) Code() string { return money.GBP }
+
+// GEL is the tag of the GEL currency.
+type GEL struct{}
+
+// Code returns "GEL".
+func (GEL) Code() string { return money.GEL }
+
+// GGP is the tag of the GGP currency.
+type GGP struct{}
+
+// Code returns "GGP".
+func (GGP) Code() string { return money.GGP }
+
+// GHC is the tag of the GHC currency.
+type GHC struct{}
+
+// Code returns "GHC".
+func (GHC) Code() string { return money.GHC }
+
+// GHS is the tag of the GHS currency.
+type GHS struct{}
+
+// Code returns "GHS".
+func (GHS) Code() string { return money.GHS }
+
+// GIP is the tag of the GIP currency.
+type GIP struct{}
+
+// Code returns "GIP".
+func (GIP) Code() string { return money.GIP }
+
+// GMD is the tag of the GMD currency.
+type GMD struct{}
+
+// Code returns "GMD".
+func (GMD) Code() string { return money.GMD }
+
+// GNF is the tag of the GNF currency.
+type GNF struct{}
+
+// Code returns "GNF".
+func (GNF) Code() string { return money.GNF }
+
+// GTQ is the tag of the GTQ currency.
+type GTQ struct{}
+
+// Code returns "GTQ".
+func (GTQ) Code() string { return money.GTQ }
+
+// GYD is the tag of the GYD currency.
+type GYD struct{}
+
+// Code returns "GYD".
+func (GYD) Code() string { return money.GYD }
+
+// HKD is the tag of the HKD currency.
+type HKD struct{}
+
+// Code returns "HKD".
+func (HKD) Code() string { return money.HKD }
+
+// HNL is the tag of the HNL currency.
+type HNL struct{}
+
+// Code returns "HNL".
+func (HNL) Code() string { return money.HNL }
+
+// HRK is the tag of the HRK currency.
+type HRK struct{}
+
+// Code returns "HRK".
+func (HRK) Code() string { return money.HRK }
+
+// HTG is the tag of the HTG currency.
+type HTG struct{}
+
+// Code returns "HTG".
+func (HTG) Code() string { return money.HTG }
+
+// HUF is the tag of the HUF currency.
+type HUF struct{}
+
+// Code returns "HUF".
+func (HUF) Code() string { return money.HUF }
+
+// IDR is the tag of the IDR currency.
+type IDR struct{}
+
+// Code returns "IDR".
+func (IDR) Code() string { return money.IDR }
+
+// ILS is the tag of the ILS currency.
+type ILS struct{}
+
+// Code returns "ILS".
+func (ILS) Code() string { return money.ILS }
+
+// IMP is the tag of the IMP currency.
+type IMP struct{}
+
+// Code returns "IMP".
+func (IMP) Code() string { return money.IMP }
+
+// INR is the tag of the INR currency.
+type INR struct{}
+
+// Code returns "INR".
+func (INR) Code() string { return money.INR }
+
+// IQD is the tag of the IQD currency.
+type IQD struct{}
+
+// Code returns "IQD".
+func (IQD) Code() string { return money.IQD }
+
+// IRR is the tag of the IRR currency.
+type IRR struct{}
+
+// Code returns "IRR".
+func (IRR) Code() string { return money.IRR }
+
+// ISK is the tag of the ISK currency.
+type ISK struct{}
+
+// Code returns "ISK".
+func (ISK) Code() string { return money.ISK }
+
+// JEP is the tag of the JEP currency.
+type JEP struct{}
+
+// Code returns "JEP".
+func (JEP) Code() string { return money.JEP }
+
+// JMD is the tag of the JMD currency.
+type JMD struct{}
+
+// Code returns "JMD".
+func (JMD) Code() string { return money.JMD }
+
+// JOD is the tag of the JOD currency.
+type JOD struct{}
+
+// Code returns "JOD".
+func (JOD) Code() string { return money.JOD }
+
+// JPY is the tag of the JPY currency.
+type JPY struct{}
+
+// Code returns "JPY".
+func (JPY) Code() string { return money.JPY }
+
+// KES is the tag of the KES currency.
+type KES struct{}
+
+// Code returns "KES".
+func (KES) Code() string { return money.KES }
+
+// KGS is the tag of the KGS currency.
+type KGS struct{}
+
+// Code returns "KGS".
+func (KGS) Code() string { return money.KGS }
+
+// KHR is the tag of the KHR currency.
+type KHR struct{}
+
+// Code returns "KHR".
+func (KHR) Code() string { return money.KHR }
+
+// KMF is the tag of the KMF currency.
+type KMF struct{}
+
+// Code returns "KMF".
+func (KMF) Code() string { return money.KMF }
+
+// KPW is the tag of the KPW currency.
+type KPW struct{}
+
+// Code returns "KPW".
+func (KPW) Code() string { return money.KPW }
+
+// KRW is the tag of the KRW currency.
+type KRW struct{}
+
+// Code returns "KRW".
+func (KRW) Code() string { return money.KRW }
+
+// KWD is the tag of the KWD currency.
+type KWD struct{}
+
+// Code returns "KWD".
+func (KWD) Code() string { return money.KWD }
+
+// KYD is the tag of the KYD currency.
+type KYD struct{}
+
+// Code returns "KYD".
+func (KYD) Code() string { return money.KYD }
+
+// KZT is the tag of the KZT currency.
+type KZT struct{}
+
+// Code returns "KZT".
+func (KZT) Code() string { return money.KZT }
+
+// LAK is the tag of the LAK currency.
+type LAK struct{}
+
+// Code returns "LAK".
+func (LAK) Code() string { return money.LAK }
+
+// LBP is the tag of the LBP currency.
+type LBP struct{}
+
+// Code returns "LBP".
+func (LBP) Code() string { return money.LBP }
+
+// LKR is the tag of the LKR currency.
+type LKR struct{}
+
+// Code returns "LKR".
+func (LKR) Code() string { return money.LKR }
+
+// LRD is the tag of the LRD currency.
+type LRD struct{}
+
+// Code returns "LRD".
+func (LRD) Code() string { return money.LRD }
+
+// LSL is the tag of the LSL currency.
+type LSL struct{}
+
+// Code returns "LSL".
+func (LSL) Code() string { return money.LSL }
+
+// LTL is the tag of the LTL currency.
+type LTL struct{}
+
+// Code returns "LTL".
+func (LTL) Code() string { return money.LTL }
+
+// LVL is the tag of the LVL currency.
+type LVL struct{}
+
+// Code returns "LVL".
+func (LVL) Code() string { return money.LVL }
+
+// LYD is the tag of the LYD currency.
+type LYD struct{}
+
+// Code returns "LYD".
+func (LYD) Code() string { return money.LYD }
+
+// MAD is the tag of the MAD currency.
+type MAD struct{}
+
+// Code returns "MAD".
+func (MAD) Code() string { return money.MAD }
+
+// MDL is the tag of the MDL currency.
+type MDL struct{}
+
+// Code returns "MDL".
+func (MDL) Code() string { return money.MDL }
+
+// MGA is the tag of the MGA currency.
+type MGA struct{}
+
+// Code returns "MGA".
+func (MGA) Code() string { return money.MGA }
+
+// MKD is the tag of the MKD currency.
+type MKD struct{}
+
+// Code returns "MKD".
+func (MKD) Code() string { return money.MKD }
+
+// MMK is the tag of the MMK currency.
+type MMK struct{}
+
+// Code returns "MMK".
+func (MMK) Code() string { return money.MMK }
+
+// MNT is the tag of the MNT currency.
+type MNT struct{}
+
+// Code returns "MNT".
+func (MNT) Code() string { return money.MNT }
+
+// MOP is the tag of the MOP currency.
+type MOP struct{}
+
+// Code returns "MOP".
+func (MOP) Code() string { return money.MOP }
+
+// MRU is the tag of the MRU currency.
+type MRU struct{}
+
+// Code returns "MRU".
+func (MRU) Code() string { return money.MRU }
+
+// MUR is the tag of the MUR currency.
+type MUR struct{}
+
+// Code returns "MUR".
+func (MUR) Code() string { return money.MUR }
+
+// MVR is the tag of the MVR currency.
+type MVR struct{}
+
+// Code returns "MVR".
+func (MVR) Code() string { return money.MVR }
+
+// MWK is the tag of the MWK currency.
+type MWK struct{}
+
+// Code returns "MWK".
+func (MWK) Code() string { return money.MWK }
+
+// MXN is the tag of the MXN currency.
+type MXN struct{}
+
+// Code returns "MXN".
+func (MXN) Code() string { return money.MXN }
+
+// MYR is the tag of the MYR currency.
+type MYR struct{}
+
+// Code returns "MYR".
+func (MYR) Code() string { return money.MYR }
+
+// MZN is the tag of the MZN currency.
+type MZN struct{}
+
+// Code returns "MZN".
+func (MZN) Code() string { return money.MZN }
+
+// NAD is the tag of the NAD currency.
+type NAD struct{}
+
+// Code returns "NAD".
+func (NAD) Code() string { return money.NAD }
+
+// NGN is the tag of the NGN currency.
+type NGN struct{}
+
+// Code returns "NGN".
+func (NGN) Code() string { return money.NGN }
+
+// NIO is the tag of the NIO currency.
+type NIO struct{}
+
+// Code returns "NIO".
+func (NIO) Code() string { return money.NIO }
+
+// NOK is the tag of the NOK currency.
+type NOK struct{}
+
+// Code returns "NOK".
+func (NOK) Code() string { return money.NOK }
+
+// NPR is the tag of the NPR currency.
+type NPR struct{}
+
+// Code returns "NPR".
+func (NPR) Code() string { return money.NPR }
+
+// NZD is the tag of the NZD currency.
+type NZD struct{}
+
+// Code returns "NZD".
+func (NZD) Code() string { return money.NZD }
+
+// OMR is the tag of the OMR currency.
+type OMR struct{}
+
+// Code returns "OMR".
+func (OMR) Code() string { return money.OMR }
+
+// PAB is the tag of the PAB currency.
+type PAB struct{}
+
+// Code returns "PAB".
+func (PAB) Code() string { return money.PAB }
+
+// PEN is the tag of the PEN currency.
+type PEN struct{}
+
+// Code returns "PEN".
+func (PEN) Code() string { return money.PEN }
+
+// PGK is the tag of the PGK currency.
+type PGK struct{}
+
+// Code returns "PGK".
+func (PGK) Code() string { return money.PGK }
+
+// PHP is the tag of the PHP currency.
+type PHP struct{}
+
+// Code returns "PHP".
+func (PHP) Code() string { return money.PHP }
+
+// PKR is the tag of the PKR currency.
+type PKR struct{}
+
+// Code returns "PKR".
+func (PKR) Code() string { return money.PKR }
+
+// PLN is the tag of the PLN currency.
+type PLN struct{}
+
+// Code returns "PLN".
+func (PLN) Code() string { return money.PLN }
+
+// PYG is the tag of the PYG currency.
+type PYG struct{}
+
+// Code returns "PYG".
+func (PYG) Code() string { return money.PYG }
+
+// QAR is the tag of the QAR currency.
+type QAR struct{}
+
+// Code returns "QAR".
+func (QAR) Code() string { return money.QAR }
+
+// RON is the tag of the RON currency.
+type RON struct{}
+
+// Code returns "RON".
+func (RON) Code() string { return money.RON }
+
+// RSD is the tag of the RSD currency.
+type RSD struct{}
+
+// Code returns "RSD".
+func (RSD) Code() string { return money.RSD }
+
+// RUB is the tag of the RUB currency.
+type RUB struct{}
+
+// Code returns "RUB".
+func (RUB) Code() string { return money.RUB }
+
+// RUR is the tag of the RUR currency.
+type RUR struct{}
+
+// Code returns "RUR".
+func (RUR) Code() string { return money.RUR }
+
+// RWF is the tag of the RWF currency.
+type RWF struct{}
+
+// Code returns "RWF".
+func (RWF) Code() string { return money.RWF }
+
+// SAR is the tag of the SAR currency.
+type SAR struct{}
+
+// Code returns "SAR".
+func (SAR) Code() string { return money.SAR }
+
+// SBD is the tag of the SBD currency.
+type SBD struct{}
+
+// Code returns "SBD".
+func (SBD) Code() string { return money.SBD }
+
+// SCR is the tag of the SCR currency.
+type SCR struct{}
+
+// Code returns "SCR".
+func (SCR) Code() string { return money.SCR }
+
+// SDG is the tag of the SDG currency.
+type SDG struct{}
+
+// Code returns "SDG".
+func (SDG) Code() string { return money.SDG }
+
+// SEK is the tag of the SEK currency.
+type SEK struct{}
+
+// Code returns "SEK".
+func (SEK) Code() string { return money.SEK }
+
+// SGD is the tag of the SGD currency.
+type SGD struct{}
+
+// Code returns "SGD".
+func (SGD) Code() string { return money.SGD }
+
+// SHP is the tag of the SHP currency.
+type SHP struct{}
+
+// Code returns "SHP".
+func (SHP) Code() string { return money.SHP }
+
+// SKK is the tag of the SKK currency.
+type SKK struct{}
+
+// Code returns "SKK".
+func (SKK) Code() string { return money.SKK }
+
+// SLE is the tag of the SLE currency.
+type SLE struct{}
+
+// Code returns "SLE".
+func (SLE) Code() string { return money.SLE }
+
+// SLL is the tag of the SLL currency.
+type SLL struct{}
+
+// Code returns "SLL".
+func (SLL) Code() string { return money.SLL }
+
+// SOS is the tag of the SOS currency.
+type SOS struct{}
+
+// Code returns "SOS".
+func (SOS) Code() string { return money.SOS }
+
+// SRD is the tag of the SRD currency.
+type SRD struct{}
+
+// Code returns "SRD".
+func (SRD) Code() string { return money.SRD }
+
+// SSP is the tag of the SSP currency.
+type SSP struct{}
+
+// Code returns "SSP".
+func (SSP) Code() string { return money.SSP }
+
+// STD is the tag of the STD currency.
+type STD struct{}
+
+// Code returns "STD".
+func (STD) Code() string { return money.STD }
+
+// STN is the tag of the STN currency.
+type STN struct{}
+
+// Code returns "STN".
+func (STN) Code() string { return money.STN }
+
+// SVC is the tag of the SVC currency.
+type SVC struct{}
+
+// Code returns "SVC".
+func (SVC) Code() string { return money.SVC }
+
+// SYP is the tag of the SYP currency.
+type SYP struct{}
+
+// Code returns "SYP".
+func (SYP) Code() string { return money.SYP }
+
+// SZL is the tag of the SZL currency.
+type SZL struct{}
+
+// Code returns "SZL".
+func (SZL) Code() string { return money.SZL }
+
+// THB is the tag of the THB currency.
+type THB struct{}
+
+// Code returns "THB".
+func (THB) Code() string { return money.THB }
+
+// TJS is the tag of the TJS currency.
+type TJS struct{}
+
+// Code returns "TJS".
+func (TJS) Code() string { return money.TJS }
+
+// TMT is the tag of the TMT currency.
+type TMT struct{}
+
+// Code returns "TMT".
+func (TMT) Code() string { return money.TMT }
+
+// TND is the tag of the TND currency.
+type TND struct{}
+
+// Code returns "TND".
+func (TND) Code() string { return money.TND }
+
+// TOP is the tag of the TOP currency.
+type TOP struct{}
+
+// Code returns "TOP".
+func (TOP) Code() string { return money.TOP }
+
+// TRL is the tag of the TRL currency.
+type TRL struct{}
+
+// Code returns "TRL".
+func (TRL) Code() string { return money.TRL }
+
+// TRY is the tag of the TRY currency.
+type TRY struct{}
+
+// Code returns "TRY".
+func (TRY) Code() string { return money.TRY }
+
+// TTD is the tag of the TTD currency.
+type TTD struct{}
+
+// Code returns "TTD".
+func (TTD) Code() string { return money.TTD }
+
+// TWD is the tag of the TWD currency.
+type TWD struct{}
+
+// Code returns "TWD".
+func (TWD) Code() string { return money.TWD }
+
+// TZS is the tag of the TZS currency.
+type TZS struct{}
+
+// Code returns "TZS".
+func (TZS) Code() string { return money.TZS }
+
+// UAH is the tag of the UAH currency.
+type UAH struct{}
+
+// Code returns "UAH".
+func (UAH) Code() string { return money.UAH }
+
+// UGX is the tag of the UGX currency.
+type UGX struct{}
+
+// Code returns "UGX".
+func (UGX) Code() string { return money.UGX }
+
+// USD is the tag of the USD currency.
+type USD struct{}
+
+// Code returns "USD".
+func (USD) Code() string { return money.USD }
+
+// UYU is the tag of the UYU currency.
+type UYU struct{}
+
+// Code returns "UYU".
+func (UYU) Code() string { return money.UYU }
+
+// UZS is the tag of the UZS currency.
+type UZS struct{}
+
+// Code returns "UZS".
+func (UZS) Code() string { return money.UZS }
+
+// VEF is the tag of the VEF currency.
+type VEF struct{}
+
+// Code returns "VEF".
+func (VEF) Code() string { return money.VEF }
+
+// VES is the tag of the VES currency.
+type VES struct{}
+
+// Code returns "VES".
+func (VES) Code() string { return money.VES }
+
+// VND is the tag of the VND currency.
+type VND struct{}
+
+// Code returns "VND".
+func (VND) Code() string { return money.VND }
+
+// VUV is the tag of the VUV currency.
+type VUV struct{}
+
+// Code returns "VUV".
+func (VUV) Code() string { return money.VUV }
+
+// WST is the tag of the WST currency.
+type WST struct{}
+
+// Code returns "WST".
+func (WST) Code() string { return money.WST }
+
+// XAF is the tag of the XAF currency.
+type XAF struct{}
+
+// Code returns "XAF".
+func (XAF) Code() string { return money.XAF }
+
+// XAG is the tag of the XAG currency.
+type XAG struct{}
+
+// Code returns "XAG".
+func (XAG) Code() string { return money.XAG }
+
+// XAU is the tag of the XAU currency.
+type XAU struct{}
+
+// Code returns "XAU".
+func (XAU) Code() string { return money.XAU }
+
+// XCD is the tag of the XCD currency.
+type XCD struct{}
+
+// Code returns "XCD".
+func (XCD) Code() string { return money.XCD }
+
+// XDR is the tag of the XDR currency.
+type XDR struct{}
+
+// Code returns "XDR".
+func (XDR) Code() string { return money.XDR }
+
+// XOF is the tag of the XOF currency.
+type XOF struct{}
+
+// Code returns "XOF".
+func (XOF) Code() string { return money.XOF }
+
+// XPF is the tag of the XPF currency.
+type XPF struct{}
+
+// Code returns "XPF".
+func (XPF) Code() string { return money.XPF }
+
+// YER is the tag of the YER currency.
+type YER struct{}
+
+// Code returns "YER".
+func (YER) Code() string { return money.YER }
+
+// ZAR is the tag of the ZAR currency.
+type ZAR struct{}
+
+// Code returns "ZAR".
+func (ZAR) Code() string { return money.ZAR }
+
+// ZMW is the tag of the ZMW currency.
+type ZMW struct{}
+
+// Code returns "ZMW".
+func (ZMW) Code() string { return money.ZMW }
+
+// ZWD is the tag of the ZWD currency.
+type ZWD struct{}
+
+// Code returns "ZWD".
+func (ZWD) Code() string { return money.ZWD }
+
+// ZWG is the tag of the ZWG currency.
+type ZWG struct{}
+
+// Code returns "ZWG".
+func (ZWG) Code() string { return money.ZWG }
+
+// ZWL is the tag of the ZWL currency.
+type ZWL struct{}
+
+// Code returns "ZWL".
+func (ZWL) Code() string { return money.ZWL }
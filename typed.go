@@ -0,0 +1,255 @@
+package money
+
+import "errors"
+
+// CurrencyTag is a type standing for a currency, see the tag package for one per currency constant.
+// Tags are used as the type parameter of Typed. They must be empty structs, so the zero value of a tag
+// can be asked for its code and pointer types such as *tag.EUR, whose zero value is nil, are rejected.
+type CurrencyTag interface {
+	~struct{}
+	// Code returns the currency code the tag stands for.
+	Code() string
+}
+
+// Typed represents monetary value in the currency C, e.g. Typed[tag.EUR].
+//
+// As the currency is part of the type, mixing currencies is caught by the compiler:
+// Typed[tag.EUR].Add only accepts Typed[tag.EUR], and comparisons can't fail.
+// Typed is a value type, the zero value is a zero amount in the currency C.
+type Typed[C CurrencyTag] struct {
+	amount Amount
+}
+
+// NewTyped creates and returns new Typed Money in the currency C.
+func NewTyped[C CurrencyTag](amount int64) Typed[C] {
+	return Typed[C]{amount: amount}
+}
+
+// TypedFrom returns Typed Money with the amount of m. It returns ErrCurrencyMismatch when the currency
+// of m isn't C, the zero value of Money is accepted as zero in any currency.
+func TypedFrom[C CurrencyTag](m *Money) (Typed[C], error) {
+	var c C
+	if cur := m.Currency(); cur != nil && cur.Code != c.Code() {
+		return Typed[C]{}, &CurrencyMismatchError{Op: "TypedFrom", Left: c.Code(), Right: cur.Code}
+	}
+
+	return Typed[C]{amount: m.Amount()}, nil
+}
+
+func (t Typed[C]) code() string {
+	var c C
+	return c.Code()
+}
+
+// Money returns new Money with the amount and currency of Typed Money.
+func (t Typed[C]) Money() *Money {
+	return New(t.amount, t.code())
+}
+
+// Amount returns the monetary value as an int64.
+func (t Typed[C]) Amount() int64 {
+	return t.amount
+}
+
+// Currency returns the currency C.
+func (t Typed[C]) Currency() *Currency {
+	return newCurrency(t.code()).get()
+}
+
+// Equals checks equality between two Typed Money.
+func (t Typed[C]) Equals(ot Typed[C]) bool {
+	return t.amount == ot.amount
+}
+
+// GreaterThan checks whether the value of Typed Money is greater than the other.
+func (t Typed[C]) GreaterThan(ot Typed[C]) bool {
+	return t.amount > ot.amount
+}
+
+// GreaterThanOrEqual checks whether the value of Typed Money is greater or equal than the other.
+func (t Typed[C]) GreaterThanOrEqual(ot Typed[C]) bool {
+	return t.amount >= ot.amount
+}
+
+// LessThan checks whether the value of Typed Money is less than the other.
+func (t Typed[C]) LessThan(ot Typed[C]) bool {
+	return t.amount < ot.amount
+}
+
+// LessThanOrEqual checks whether the value of Typed Money is less or equal than the other.
+func (t Typed[C]) LessThanOrEqual(ot Typed[C]) bool {
+	return t.amount <= ot.amount
+}
+
+// Compare returns 1, 0 or -1 when Typed Money is greater than, equal to or less than the other.
+func (t Typed[C]) Compare(ot Typed[C]) int {
+	switch {
+	case t.amount > ot.amount:
+		return 1
+	case t.amount < ot.amount:
+		return -1
+	}
+
+	return 0
+}
+
+// IsZero returns boolean of whether the value of Typed Money is equals to zero.
+func (t Typed[C]) IsZero() bool {
+	return t.amount == 0
+}
+
+// IsPositive returns boolean of whether the value of Typed Money is positive.
+func (t Typed[C]) IsPositive() bool {
+	return t.amount > 0
+}
+
+// IsNegative returns boolean of whether the value of Typed Money is negative.
+func (t Typed[C]) IsNegative() bool {
+	return t.amount < 0
+}
+
+// Sign returns -1, 0 or 1 depending on whether the value of Typed Money is negative, zero or positive.
+func (t Typed[C]) Sign() int {
+	return t.Compare(Typed[C]{})
+}
+
+// Absolute returns Typed Money with the absolute monetary value.
+func (t Typed[C]) Absolute() Typed[C] {
+	return Typed[C]{amount: mutate.calc.absolute(t.amount)}
+}
+
+// Negative returns Typed Money with the negative monetary value.
+func (t Typed[C]) Negative() Typed[C] {
+	return Typed[C]{amount: mutate.calc.negative(t.amount)}
+}
+
+// Add returns Typed Money with value representing sum of Self and Other Typed Money.
+// It returns ErrOverflow when the sum doesn't fit into int64.
+func (t Typed[C]) Add(ts ...Typed[C]) (Typed[C], error) {
+	as := make([]Amount, len(ts))
+	for i, t2 := range ts {
+		as[i] = t2.amount
+	}
+
+	a, err := mutate.calc.sum(t.amount, as, false)
+	if err != nil {
+		return Typed[C]{}, overflowed(err, "Add", t.Currency())
+	}
+
+	return Typed[C]{amount: a}, nil
+}
+
+// Subtract returns Typed Money with value representing difference of Self and Other Typed Money.
+// It returns ErrOverflow when the difference doesn't fit into int64.
+func (t Typed[C]) Subtract(ts ...Typed[C]) (Typed[C], error) {
+	as := make([]Amount, len(ts))
+	for i, t2 := range ts {
+		as[i] = t2.amount
+	}
+
+	a, err := mutate.calc.sum(t.amount, as, true)
+	if err != nil {
+		return Typed[C]{}, overflowed(err, "Subtract", t.Currency())
+	}
+
+	return Typed[C]{amount: a}, nil
+}
+
+// Multiply returns Typed Money with value representing Self multiplied by the multipliers.
+// It returns ErrOverflow when the product doesn't fit into int64.
+func (t Typed[C]) Multiply(muls ...int64) (Typed[C], error) {
+	m, err := t.Money().MultiplyE(muls...)
+	return typedResult[C](m, err)
+}
+
+// Divide returns Typed Money with value representing Self divided by d, rounded using the given mode.
+func (t Typed[C]) Divide(d int64, mode RoundingMode) (Typed[C], error) {
+	m, err := t.Money().Divide(d, mode)
+	return typedResult[C](m, err)
+}
+
+// Round returns Typed Money with value rounded to the nearest whole major unit, ties are rounded toward zero.
+// Like Money.Round it doesn't take a rounding mode, see RoundWithMode. Unlike it, it returns ErrOverflow.
+func (t Typed[C]) Round() (Typed[C], error) {
+	return t.RoundWithMode(RoundHalfDown)
+}
+
+// RoundWithMode returns Typed Money with value rounded to a whole major unit using the given rounding mode.
+func (t Typed[C]) RoundWithMode(mode RoundingMode) (Typed[C], error) {
+	m, err := t.Money().RoundWithMode(mode)
+	return typedResult[C](m, err)
+}
+
+// Split returns slice of Typed Money with split Self value in given number.
+func (t Typed[C]) Split(n int) ([]Typed[C], error) {
+	ms, err := t.Money().Split(n)
+	return typedResults[C](ms, err)
+}
+
+// Allocate returns slice of Typed Money with split Self value in given ratios.
+func (t Typed[C]) Allocate(rs ...int) ([]Typed[C], error) {
+	ms, err := t.Money().Allocate(rs...)
+	return typedResults[C](ms, err)
+}
+
+// Display lets represent Typed Money as string in the currency C.
+func (t Typed[C]) Display() string {
+	return t.Currency().Formatter().Format(t.amount)
+}
+
+// String implements fmt.Stringer using Display.
+func (t Typed[C]) String() string {
+	return t.Display()
+}
+
+// AsMajorUnits lets represent Typed Money as major units (float64) in the currency C.
+func (t Typed[C]) AsMajorUnits() float64 {
+	return t.Currency().Formatter().ToMajorUnits(t.amount)
+}
+
+// MarshalJSON is implementation of json.Marshaller, using the format of Money.
+func (t Typed[C]) MarshalJSON() ([]byte, error) {
+	return t.Money().MarshalJSON()
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller, using the format of Money.
+// It returns ErrCurrencyMismatch when the currency isn't C.
+func (t *Typed[C]) UnmarshalJSON(b []byte) error {
+	if t == nil {
+		return errors.New("can't unmarshal into nil Typed")
+	}
+
+	var m Money
+	if err := m.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	ot, err := TypedFrom[C](&m)
+	if err != nil {
+		return err
+	}
+
+	*t = ot
+	return nil
+}
+
+func typedResult[C CurrencyTag](m *Money, err error) (Typed[C], error) {
+	if err != nil {
+		return Typed[C]{}, err
+	}
+
+	return Typed[C]{amount: m.Amount()}, nil
+}
+
+func typedResults[C CurrencyTag](ms []*Money, err error) ([]Typed[C], error) {
+	if err != nil {
+		return nil, err
+	}
+
+	ts := make([]Typed[C], len(ms))
+	for i, m := range ms {
+		ts[i] = Typed[C]{amount: m.Amount()}
+	}
+
+	return ts, nil
+}
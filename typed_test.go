@@ -0,0 +1,200 @@
+package money_test
+
+import (
+	"encoding/json"
+	"errors"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/Rhymond/go-money/tag"
+)
+
+func TestTyped(t *testing.T) {
+	m := money.NewTyped[tag.EUR](100)
+
+	if m.Amount() != 100 || m.Currency().Code != money.EUR {
+		t.Errorf("Expected 100 EUR got %d %s", m.Amount(), m.Currency().Code)
+	}
+
+	if m.Display() != "€1.00" {
+		t.Errorf("Expected %s got %s", "€1.00", m.Display())
+	}
+
+	var zero money.Typed[tag.JPY]
+	if !zero.IsZero() || zero.Currency().Code != money.JPY {
+		t.Errorf("Expected zero JPY got %d %s", zero.Amount(), zero.Currency().Code)
+	}
+}
+
+func TestTyped_Arithmetic(t *testing.T) {
+	m := money.NewTyped[tag.EUR](100)
+
+	r, err := m.Add(money.NewTyped[tag.EUR](50), money.NewTyped[tag.EUR](-30))
+	if err != nil || r.Amount() != 120 {
+		t.Errorf("Expected 120 got %d, %v", r.Amount(), err)
+	}
+
+	r, err = m.Subtract(money.NewTyped[tag.EUR](150))
+	if err != nil || r.Amount() != -50 || r.Sign() != -1 || r.Absolute().Amount() != 50 {
+		t.Errorf("Expected -50 got %d, %v", r.Amount(), err)
+	}
+
+	r, err = m.Multiply(3)
+	if err != nil || r.Amount() != 300 {
+		t.Errorf("Expected 300 got %d, %v", r.Amount(), err)
+	}
+
+	r, err = m.Divide(3, money.RoundHalfUp)
+	if err != nil || r.Amount() != 33 {
+		t.Errorf("Expected 33 got %d, %v", r.Amount(), err)
+	}
+
+	r, err = money.NewTyped[tag.EUR](150).Round()
+	if err != nil || r.Amount() != 100 {
+		t.Errorf("Expected 100 got %d, %v", r.Amount(), err)
+	}
+
+	r, err = money.NewTyped[tag.EUR](150).RoundWithMode(money.RoundHalfUp)
+	if err != nil || r.Amount() != 200 {
+		t.Errorf("Expected 200 got %d, %v", r.Amount(), err)
+	}
+
+	ms, err := m.Split(3)
+	if err != nil || len(ms) != 3 || ms[0].Amount() != 34 {
+		t.Errorf("Expected [34 33 33] got %v, %v", ms, err)
+	}
+
+	_, err = money.NewTyped[tag.EUR](math.MaxInt64).Add(m)
+	var oe *money.OverflowError
+	if !errors.As(err, &oe) || oe.Op != "Add" || oe.Currency != money.EUR {
+		t.Errorf("Expected Add EUR overflow got %v", err)
+	}
+
+	r, err = money.NewTyped[tag.EUR](math.MaxInt64).Add(money.NewTyped[tag.EUR](1), money.NewTyped[tag.EUR](-1))
+	if err != nil || r.Amount() != math.MaxInt64 {
+		t.Errorf("Expected %d got %d, %v", int64(math.MaxInt64), r.Amount(), err)
+	}
+
+	r, err = money.NewTyped[tag.EUR](math.MaxInt64).Subtract(money.NewTyped[tag.EUR](math.MaxInt64), money.NewTyped[tag.EUR](1))
+	if err != nil || r.Amount() != -1 {
+		t.Errorf("Expected -1 got %d, %v", r.Amount(), err)
+	}
+}
+
+func TestTyped_Comparison(t *testing.T) {
+	m, om := money.NewTyped[tag.USD](10), money.NewTyped[tag.USD](20)
+
+	if m.Compare(om) != -1 || !m.LessThan(om) || !m.LessThanOrEqual(om) || m.GreaterThan(om) ||
+		m.GreaterThanOrEqual(om) || m.Equals(om) || !m.Equals(money.NewTyped[tag.USD](10)) {
+		t.Errorf("Unexpected comparison of %v and %v", m, om)
+	}
+}
+
+func TestTyped_Money(t *testing.T) {
+	m := money.NewTyped[tag.GBP](250)
+
+	om := m.Money()
+	if om.Amount() != 250 || om.Currency().Code != money.GBP {
+		t.Errorf("Expected 250 GBP got %d %s", om.Amount(), om.Currency().Code)
+	}
+
+	r, err := money.TypedFrom[tag.GBP](om)
+	if err != nil || r != m {
+		t.Errorf("Expected %v got %v, %v", m, r, err)
+	}
+
+	r, err = money.TypedFrom[tag.GBP](nil)
+	if err != nil || !r.IsZero() {
+		t.Errorf("Expected zero got %v, %v", r, err)
+	}
+
+	_, err = money.TypedFrom[tag.EUR](om)
+	var me *money.CurrencyMismatchError
+	if !errors.As(err, &me) || me.Op != "TypedFrom" || me.Left != money.EUR || me.Right != money.GBP {
+		t.Errorf("Expected TypedFrom EUR != GBP got %v", err)
+	}
+}
+
+func TestTyped_JSON(t *testing.T) {
+	b, err := json.Marshal(money.NewTyped[tag.EUR](123))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m money.Typed[tag.EUR]
+	if err := json.Unmarshal(b, &m); err != nil || m.Amount() != 123 {
+		t.Errorf("Expected 123 got %d, %v", m.Amount(), err)
+	}
+
+	var om money.Typed[tag.USD]
+	if err := json.Unmarshal(b, &om); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", money.ErrCurrencyMismatch, err)
+	}
+}
+
+// TestTyped_MixedCurrencies checks that mixing currencies is rejected by the type checker.
+func TestTyped_MixedCurrencies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("type checking imports the package from source")
+	}
+
+	src := `package p
+
+import (
+	"github.com/Rhymond/go-money"
+	"github.com/Rhymond/go-money/tag"
+)
+
+func f() {
+	_, _ = money.NewTyped[tag.EUR](1).Add(money.NewTyped[tag.USD](1))
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("p", fset, []*ast.File{f}, nil)
+	if err == nil || !strings.Contains(err.Error(), "cannot use") {
+		t.Errorf("Expected adding USD to EUR not to compile got %v", err)
+	}
+}
+
+// TestTyped_PointerTag checks that pointer tags, whose zero value is nil, are rejected by the type checker.
+func TestTyped_PointerTag(t *testing.T) {
+	if testing.Short() {
+		t.Skip("type checking imports the package from source")
+	}
+
+	src := `package p
+
+import (
+	"github.com/Rhymond/go-money"
+	"github.com/Rhymond/go-money/tag"
+)
+
+func f() {
+	_ = money.NewTyped[*tag.EUR](100).Display()
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("p", fset, []*ast.File{f}, nil)
+	if err == nil || !strings.Contains(err.Error(), "does not satisfy") {
+		t.Errorf("Expected a pointer tag not to compile got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package money
+
+import (
+	v1 "github.com/Rhymond/go-money"
+)
+
+// FromV1 returns the value of v1 Money. A nil *Money gives the zero value.
+func FromV1(m *v1.Money) Money {
+	if m == nil {
+		return Money{}
+	}
+
+	var code string
+	if c := m.Currency(); c != nil {
+		code = c.Code
+	}
+
+	return Money{amount: m.Amount(), currency: code}
+}
+
+// FromV1Err is FromV1 for the results of v1 operations which can fail.
+func FromV1Err(m *v1.Money, err error) (Money, error) {
+	if err != nil {
+		return Money{}, err
+	}
+
+	return FromV1(m), nil
+}
+
+func fromV1Slice(ms []*v1.Money, err error) ([]Money, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	vs := make([]Money, len(ms))
+	for i, m := range ms {
+		vs[i] = FromV1(m)
+	}
+
+	return vs, nil
+}
+
+// V1 returns newly allocated v1 Money with the same amount and currency.
+// The zero value gives the zero value of v1 Money.
+func (m Money) V1() *v1.Money {
+	if m == (Money{}) {
+		return &v1.Money{}
+	}
+
+	return v1.New(m.amount, m.currency)
+}
@@ -0,0 +1,52 @@
+package money
+
+import (
+	"testing"
+
+	v1 "github.com/Rhymond/go-money"
+)
+
+func TestFromV1(t *testing.T) {
+	tcs := []struct {
+		m        *v1.Money
+		expected Money
+	}{
+		{v1.New(100, v1.EUR), New(100, "EUR")},
+		{v1.New(-5, "foo"), New(-5, "FOO")},
+		{&v1.Money{}, Money{}},
+		{nil, Money{}},
+	}
+
+	for _, tc := range tcs {
+		if r := FromV1(tc.m); r != tc.expected {
+			t.Errorf("Expected %v got %v", tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_V1(t *testing.T) {
+	tcs := []Money{
+		New(100, "EUR"),
+		New(-5, "FOO"),
+		New(0, "JPY"),
+		{},
+	}
+
+	for _, m := range tcs {
+		om := m.V1()
+
+		if r := FromV1(om); r != m {
+			t.Errorf("Expected %v to round trip got %v", m, r)
+		}
+
+		if om.Amount() != m.Amount() {
+			t.Errorf("Expected amount %d got %d", m.Amount(), om.Amount())
+		}
+	}
+
+	// Every call returns new v1 Money, so changing it doesn't change the value.
+	m := New(100, "EUR")
+	if m.V1() == m.V1() {
+		t.Error("Expected V1 to return new Money")
+	}
+}
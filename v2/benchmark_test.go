@@ -0,0 +1,85 @@
+package money
+
+import (
+	"testing"
+
+	v1 "github.com/Rhymond/go-money"
+)
+
+func BenchmarkV1_Add(b *testing.B) {
+	b.ReportAllocs()
+	m, om := v1.New(100, v1.EUR), v1.New(1, v1.EUR)
+	for i := 0; i < b.N; i++ {
+		m, _ = m.Add(om)
+	}
+}
+
+func BenchmarkV2_Add(b *testing.B) {
+	b.ReportAllocs()
+	m, om := New(100, "EUR"), New(1, "EUR")
+	for i := 0; i < b.N; i++ {
+		m, _ = m.Add(om)
+	}
+}
+
+func BenchmarkV1_Compare(b *testing.B) {
+	b.ReportAllocs()
+	m, om := v1.New(100, v1.EUR), v1.New(1, v1.EUR)
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Compare(om)
+	}
+}
+
+func BenchmarkV2_Compare(b *testing.B) {
+	b.ReportAllocs()
+	m, om := New(100, "EUR"), New(1, "EUR")
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Compare(om)
+	}
+}
+
+func BenchmarkV1_Multiply(b *testing.B) {
+	b.ReportAllocs()
+	m := v1.New(100, v1.EUR)
+	for i := 0; i < b.N; i++ {
+		_, _ = m.MultiplyE(3)
+	}
+}
+
+func BenchmarkV2_Multiply(b *testing.B) {
+	b.ReportAllocs()
+	m := New(100, "EUR")
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Multiply(3)
+	}
+}
+
+// TestAllocations keeps the benchmarks honest: v2 arithmetic mustn't allocate at all.
+func TestAllocations(t *testing.T) {
+	m1, om1 := v1.New(100, v1.EUR), v1.New(1, v1.EUR)
+	m2, om2 := New(100, "EUR"), New(1, "EUR")
+
+	tcs := []struct {
+		name string
+		v1   func()
+		v2   func()
+	}{
+		{"Add", func() { _, _ = m1.Add(om1) }, func() { _, _ = m2.Add(om2) }},
+		{"Subtract", func() { _, _ = m1.Subtract(om1) }, func() { _, _ = m2.Subtract(om2) }},
+		{"Multiply", func() { _, _ = m1.MultiplyE(3) }, func() { _, _ = m2.Multiply(3) }},
+		{"Compare", func() { _, _ = m1.Compare(om1) }, func() { _, _ = m2.Compare(om2) }},
+	}
+
+	for _, tc := range tcs {
+		a1 := testing.AllocsPerRun(100, tc.v1)
+		a2 := testing.AllocsPerRun(100, tc.v2)
+
+		if a2 != 0 {
+			t.Errorf("Expected v2 %s not to allocate got %v allocations", tc.name, a2)
+		}
+
+		if tc.name != "Compare" && a2 >= a1 {
+			t.Errorf("Expected v2 %s to allocate less than v1 got %v >= %v", tc.name, a2, a1)
+		}
+	}
+}
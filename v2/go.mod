@@ -0,0 +1,5 @@
+module github.com/Rhymond/go-money/v2
+
+go 1.18
+
+require github.com/Rhymond/go-money v0.0.0-20261018110936-3746ea6ed3d6
@@ -0,0 +1,363 @@
+// Package money implements Money as a small comparable value type.
+//
+// Unlike *Money of github.com/Rhymond/go-money, Money of this package is passed by value,
+// can't be aliased, can be compared with == and used as a map key, and its arithmetic and
+// comparisons don't allocate. Currencies, rounding modes, allocation strategies and errors
+// are shared with the v1 package, so errors.Is works the same with both.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"math/bits"
+	"strings"
+
+	v1 "github.com/Rhymond/go-money"
+)
+
+// Currency represents money currency information required for formatting.
+type Currency = v1.Currency
+
+// RoundingMode tells operations how to round a result which can't be represented exactly.
+type RoundingMode = v1.RoundingMode
+
+// AllocationStrategy decides which parties receive the leftover subunits of an allocation.
+type AllocationStrategy = v1.AllocationStrategy
+
+// Rounding modes, see the v1 package for their description.
+const (
+	RoundHalfUp   = v1.RoundHalfUp
+	RoundHalfDown = v1.RoundHalfDown
+	RoundHalfEven = v1.RoundHalfEven
+	RoundCeiling  = v1.RoundCeiling
+	RoundFloor    = v1.RoundFloor
+	RoundUp       = v1.RoundUp
+	RoundDown     = v1.RoundDown
+)
+
+// Errors are the ones of the v1 package.
+var (
+	// ErrCurrencyMismatch happens when two compared Money don't have the same currency.
+	ErrCurrencyMismatch = v1.ErrCurrencyMismatch
+
+	// ErrOverflow happens when the result of an arithmetic operation doesn't fit into int64.
+	ErrOverflow = v1.ErrOverflow
+
+	// ErrDivisionByZero happens when Money is divided by zero.
+	ErrDivisionByZero = v1.ErrDivisionByZero
+)
+
+// Money represents monetary value information, stores currency code and amount value.
+//
+// The zero value Money{} is a zero amount without a currency. Add and Subtract treat it
+// as zero in the currency of the other Money, like the zero value of v1 Money.
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// New creates and returns new Money.
+func New(amount int64, code string) Money {
+	return Money{amount: amount, currency: strings.ToUpper(code)}
+}
+
+// Amount returns the monetary value in subunits.
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Code returns the currency code of Money, or an empty string for the zero value.
+func (m Money) Code() string {
+	return m.currency
+}
+
+// Currency returns the currency used by Money, or nil for the zero value.
+// Unregistered currency codes get the default currency like with v1 Money.
+func (m Money) Currency() *Currency {
+	return m.V1().Currency()
+}
+
+// SameCurrency check if given Money is equals by currency.
+func (m Money) SameCurrency(om Money) bool {
+	return m.currency == om.currency
+}
+
+func (m Money) assertSameCurrency(om Money, op string) error {
+	if !m.SameCurrency(om) {
+		return &v1.CurrencyMismatchError{Op: op, Left: m.currency, Right: om.currency}
+	}
+
+	return nil
+}
+
+func (m Money) compare(om Money) int {
+	switch {
+	case m.amount > om.amount:
+		return 1
+	case m.amount < om.amount:
+		return -1
+	}
+
+	return 0
+}
+
+// Equals checks equality between two Money of the same currency.
+func (m Money) Equals(om Money) (bool, error) {
+	if err := m.assertSameCurrency(om, "Equals"); err != nil {
+		return false, err
+	}
+
+	return m == om, nil
+}
+
+// GreaterThan checks whether the value of Money is greater than the other.
+func (m Money) GreaterThan(om Money) (bool, error) {
+	if err := m.assertSameCurrency(om, "GreaterThan"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) == 1, nil
+}
+
+// GreaterThanOrEqual checks whether the value of Money is greater or equal than the other.
+func (m Money) GreaterThanOrEqual(om Money) (bool, error) {
+	if err := m.assertSameCurrency(om, "GreaterThanOrEqual"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) >= 0, nil
+}
+
+// LessThan checks whether the value of Money is less than the other.
+func (m Money) LessThan(om Money) (bool, error) {
+	if err := m.assertSameCurrency(om, "LessThan"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) == -1, nil
+}
+
+// LessThanOrEqual checks whether the value of Money is less or equal than the other.
+func (m Money) LessThanOrEqual(om Money) (bool, error) {
+	if err := m.assertSameCurrency(om, "LessThanOrEqual"); err != nil {
+		return false, err
+	}
+
+	return m.compare(om) <= 0, nil
+}
+
+// Compare returns 1, 0 or -1 when Money is greater than, equal to or less than the other.
+func (m Money) Compare(om Money) (int, error) {
+	if err := m.assertSameCurrency(om, "Compare"); err != nil {
+		return 0, err
+	}
+
+	return m.compare(om), nil
+}
+
+// IsZero returns boolean of whether the value of Money is equals to zero.
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsPositive returns boolean of whether the value of Money is positive.
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+// IsNegative returns boolean of whether the value of Money is negative.
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Sign returns -1, 0 or 1 depending on whether the value of Money is negative, zero or positive.
+func (m Money) Sign() int {
+	return m.compare(Money{})
+}
+
+// Absolute returns Money with the absolute monetary value. The smallest int64 is returned unchanged.
+func (m Money) Absolute() Money {
+	if m.amount < 0 && m.amount != math.MinInt64 {
+		m.amount = -m.amount
+	}
+
+	return m
+}
+
+// Negative returns Money with the negative monetary value.
+func (m Money) Negative() Money {
+	if m.amount > 0 {
+		m.amount = -m.amount
+	}
+
+	return m
+}
+
+// commonCurrency returns the currency code shared by Self and ms, ignoring zero values without a currency.
+func (m Money) commonCurrency(ms []Money, op string) (string, error) {
+	base := m
+	for _, m2 := range ms {
+		switch {
+		case m2.currency == "":
+			continue
+		case base.currency == "":
+			base = m2
+		default:
+			if err := base.assertSameCurrency(m2, op); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return base.currency, nil
+}
+
+// Add returns Money with value representing sum of Self and Other Money.
+// It returns ErrOverflow when the sum doesn't fit into int64.
+func (m Money) Add(ms ...Money) (Money, error) {
+	c, err := m.commonCurrency(ms, "Add")
+	if err != nil {
+		return Money{}, err
+	}
+
+	a, ok := sum(m.amount, ms, false)
+	if !ok {
+		return Money{}, &v1.OverflowError{Op: "Add", Currency: c}
+	}
+
+	return Money{amount: a, currency: c}, nil
+}
+
+// Subtract returns Money with value representing difference of Self and Other Money.
+// It returns ErrOverflow when the difference doesn't fit into int64.
+func (m Money) Subtract(ms ...Money) (Money, error) {
+	c, err := m.commonCurrency(ms, "Subtract")
+	if err != nil {
+		return Money{}, err
+	}
+
+	a, ok := sum(m.amount, ms, true)
+	if !ok {
+		return Money{}, &v1.OverflowError{Op: "Subtract", Currency: c}
+	}
+
+	return Money{amount: a, currency: c}, nil
+}
+
+// sum returns a plus the amounts of ms, or minus them when subtract is set. The sum is accumulated
+// in 128 bits, so ok is false only when the final result doesn't fit into int64.
+func sum(a int64, ms []Money, subtract bool) (int64, bool) {
+	hi, lo := a>>63, uint64(a)
+	for _, m := range ms {
+		var carry uint64
+		if subtract {
+			lo, carry = bits.Sub64(lo, uint64(m.amount), 0)
+			hi = hi - m.amount>>63 - int64(carry)
+		} else {
+			lo, carry = bits.Add64(lo, uint64(m.amount), 0)
+			hi = hi + m.amount>>63 + int64(carry)
+		}
+	}
+
+	return int64(lo), hi == int64(lo)>>63
+}
+
+// Multiply returns Money with value representing Self multiplied by the multipliers.
+// It returns ErrOverflow when the product doesn't fit into int64.
+func (m Money) Multiply(muls ...int64) (Money, error) {
+	for _, k := range muls {
+		// A zero multiplier makes the product zero whatever the other factors are.
+		if k == 0 {
+			return Money{currency: m.currency}, nil
+		}
+	}
+
+	a := m.amount
+	for _, k := range muls {
+		p := a * k
+		if p/k != a || (a == -1 && k == math.MinInt64) || (k == -1 && a == math.MinInt64) {
+			return Money{}, &v1.OverflowError{Op: "Multiply", Currency: m.currency}
+		}
+
+		a = p
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
+
+// Divide returns Money with value representing Self divided by d, rounded using the given mode.
+// Dividing by zero returns ErrDivisionByZero.
+func (m Money) Divide(d int64, mode RoundingMode) (Money, error) {
+	return FromV1Err(m.V1().Divide(d, mode))
+}
+
+// Round returns Money with value rounded to a whole major unit using the given rounding mode.
+func (m Money) Round(mode RoundingMode) (Money, error) {
+	return FromV1Err(m.V1().RoundWithMode(mode))
+}
+
+// Split returns slice of Money with split Self value in given number.
+// Leftover subunits are distributed amongst the first parties.
+func (m Money) Split(n int) ([]Money, error) {
+	return fromV1Slice(m.V1().Split(n))
+}
+
+// Allocate returns slice of Money with split Self value in given ratios.
+// Leftover subunits are distributed amongst the first parties.
+func (m Money) Allocate(rs ...int) ([]Money, error) {
+	return fromV1Slice(m.V1().Allocate(rs...))
+}
+
+// AllocateWithStrategy returns slice of Money with split Self value in given ratios,
+// distributing leftover subunits in the order chosen by the strategy.
+func (m Money) AllocateWithStrategy(strategy AllocationStrategy, rs ...int) ([]Money, error) {
+	return fromV1Slice(m.V1().AllocateWithStrategy(strategy, rs...))
+}
+
+// Display lets represent Money as string in given Currency value.
+func (m Money) Display() string {
+	return m.V1().Display()
+}
+
+// String implements fmt.Stringer using Display.
+func (m Money) String() string {
+	return m.Display()
+}
+
+// AsMajorUnits lets represent Money as major units (float64) in given Currency value.
+func (m Money) AsMajorUnits() float64 {
+	return m.V1().AsMajorUnits()
+}
+
+// MarshalJSON is implementation of json.Marshaller, using the format of v1 Money.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.V1())
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller, using the format of v1 Money.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	var om v1.Money
+	if err := json.Unmarshal(b, &om); err != nil {
+		return err
+	}
+
+	*m = FromV1(&om)
+	return nil
+}
+
+// Value implements driver.Valuer like v1 Money, e.g. "amount|currency_code".
+func (m Money) Value() (driver.Value, error) {
+	return m.V1().Value()
+}
+
+// Scan implements sql.Scanner like v1 Money.
+func (m *Money) Scan(src interface{}) error {
+	var om v1.Money
+	if err := om.Scan(src); err != nil {
+		return err
+	}
+
+	*m = FromV1(&om)
+	return nil
+}
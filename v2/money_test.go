@@ -0,0 +1,293 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	m := New(1, "eur")
+
+	if m.Amount() != 1 {
+		t.Errorf("Expected %d got %d", 1, m.Amount())
+	}
+
+	if m.Code() != "EUR" {
+		t.Errorf("Expected currency %s got %s", "EUR", m.Code())
+	}
+
+	if m.Currency().Code != "EUR" {
+		t.Errorf("Expected currency %s got %s", "EUR", m.Currency().Code)
+	}
+}
+
+func TestMoney_Comparable(t *testing.T) {
+	if New(100, "EUR") != New(100, "eur") {
+		t.Errorf("Expected %v to == %v", New(100, "EUR"), New(100, "eur"))
+	}
+
+	if New(100, "EUR") == New(100, "USD") {
+		t.Errorf("Expected %v to != %v", New(100, "EUR"), New(100, "USD"))
+	}
+
+	totals := map[Money]int{}
+	totals[New(100, "EUR")]++
+	totals[New(100, "EUR")]++
+	totals[New(100, "USD")]++
+
+	if len(totals) != 2 || totals[New(100, "EUR")] != 2 {
+		t.Errorf("Expected 2 keys got %v", totals)
+	}
+}
+
+func TestMoney_NoAliasing(t *testing.T) {
+	m := New(100, "EUR")
+	om := m
+
+	sum, err := m.Add(New(50, "EUR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != 100 || om.Amount() != 100 || sum.Amount() != 150 {
+		t.Errorf("Expected 100, 100 and 150 got %d, %d and %d", m.Amount(), om.Amount(), sum.Amount())
+	}
+}
+
+func TestMoney_Comparison(t *testing.T) {
+	tcs := []struct {
+		amount1 int64
+		amount2 int64
+		compare int
+	}{
+		{10, 20, -1},
+		{20, 10, 1},
+		{10, 10, 0},
+		{math.MinInt64, math.MaxInt64, -1},
+	}
+
+	for _, tc := range tcs {
+		m, om := New(tc.amount1, "EUR"), New(tc.amount2, "EUR")
+
+		r, err := m.Compare(om)
+		if err != nil || r != tc.compare {
+			t.Errorf("Expected %d compared to %d to be %d got %d, %v", tc.amount1, tc.amount2, tc.compare, r, err)
+		}
+
+		eq, _ := m.Equals(om)
+		gt, _ := m.GreaterThan(om)
+		gte, _ := m.GreaterThanOrEqual(om)
+		lt, _ := m.LessThan(om)
+		lte, _ := m.LessThanOrEqual(om)
+
+		if eq != (tc.compare == 0) || gt != (tc.compare > 0) || gte != (tc.compare >= 0) ||
+			lt != (tc.compare < 0) || lte != (tc.compare <= 0) {
+			t.Errorf("Unexpected comparison of %d and %d: %v %v %v %v %v", tc.amount1, tc.amount2, eq, gt, gte, lt, lte)
+		}
+	}
+
+	_, err := New(1, "EUR").Compare(New(1, "USD"))
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if err.Error() != "money: Compare: currencies don't match (EUR != USD)" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+func TestMoney_Sign(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		sign     int
+		absolute int64
+		negative int64
+	}{
+		{-10, -1, 10, -10},
+		{0, 0, 0, 0},
+		{10, 1, 10, -10},
+		{math.MinInt64, -1, math.MinInt64, math.MinInt64},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, "EUR")
+
+		if m.Sign() != tc.sign || m.IsNegative() != (tc.sign < 0) || m.IsZero() != (tc.sign == 0) || m.IsPositive() != (tc.sign > 0) {
+			t.Errorf("Expected sign %d of %d got %d", tc.sign, tc.amount, m.Sign())
+		}
+
+		if m.Absolute().Amount() != tc.absolute {
+			t.Errorf("Expected absolute %d got %d", tc.absolute, m.Absolute().Amount())
+		}
+
+		if m.Negative().Amount() != tc.negative {
+			t.Errorf("Expected negative %d got %d", tc.negative, m.Negative().Amount())
+		}
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	tcs := []struct {
+		m        Money
+		ms       []Money
+		expected Money
+		err      error
+	}{
+		{New(5, "EUR"), []Money{New(10, "EUR"), New(-3, "EUR")}, New(12, "EUR"), nil},
+		{New(5, "EUR"), nil, New(5, "EUR"), nil},
+		{Money{}, []Money{New(10, "EUR")}, New(10, "EUR"), nil},
+		{New(10, "EUR"), []Money{{}}, New(10, "EUR"), nil},
+		{Money{}, []Money{{}}, Money{}, nil},
+		{New(5, "EUR"), []Money{New(10, "USD")}, Money{}, ErrCurrencyMismatch},
+		{New(math.MaxInt64, "EUR"), []Money{New(1, "EUR")}, Money{}, ErrOverflow},
+		{New(math.MinInt64, "EUR"), []Money{New(-1, "EUR")}, Money{}, ErrOverflow},
+		{New(math.MaxInt64, "EUR"), []Money{New(1, "EUR"), New(-1, "EUR")}, New(math.MaxInt64, "EUR"), nil},
+		{New(-1, "EUR"), []Money{New(math.MaxInt64, "EUR"), New(1, "EUR")}, New(math.MaxInt64, "EUR"), nil},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.m.Add(tc.ms...)
+		if !errors.Is(err, tc.err) || r != tc.expected {
+			t.Errorf("Expected %v + %v = %v, %v got %v, %v", tc.m, tc.ms, tc.expected, tc.err, r, err)
+		}
+	}
+}
+
+func TestMoney_Subtract(t *testing.T) {
+	tcs := []struct {
+		m        Money
+		ms       []Money
+		expected Money
+		err      error
+	}{
+		{New(5, "EUR"), []Money{New(10, "EUR"), New(-3, "EUR")}, New(-2, "EUR"), nil},
+		{Money{}, []Money{New(10, "EUR")}, New(-10, "EUR"), nil},
+		{New(5, "EUR"), []Money{New(10, "USD")}, Money{}, ErrCurrencyMismatch},
+		{New(math.MinInt64, "EUR"), []Money{New(1, "EUR")}, Money{}, ErrOverflow},
+		{New(0, "EUR"), []Money{New(math.MinInt64, "EUR")}, Money{}, ErrOverflow},
+		{New(math.MaxInt64, "EUR"), []Money{New(math.MaxInt64, "EUR"), New(1, "EUR")}, New(-1, "EUR"), nil},
+		{New(math.MinInt64, "EUR"), []Money{New(-1, "EUR"), New(1, "EUR")}, New(math.MinInt64, "EUR"), nil},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.m.Subtract(tc.ms...)
+		if !errors.Is(err, tc.err) || r != tc.expected {
+			t.Errorf("Expected %v - %v = %v, %v got %v, %v", tc.m, tc.ms, tc.expected, tc.err, r, err)
+		}
+	}
+}
+
+func TestMoney_Multiply(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		muls     []int64
+		expected int64
+		err      error
+	}{
+		{5, []int64{5}, 25, nil},
+		{-5, []int64{2, 3}, -30, nil},
+		{5, nil, 5, nil},
+		{math.MaxInt64, []int64{0, 2}, 0, nil},
+		{math.MaxInt64, []int64{2}, 0, ErrOverflow},
+		{math.MinInt64, []int64{-1}, 0, ErrOverflow},
+		{-1, []int64{math.MinInt64}, 0, ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, "EUR").Multiply(tc.muls...)
+		if !errors.Is(err, tc.err) || r.Amount() != tc.expected {
+			t.Errorf("Expected %d * %v = %d, %v got %d, %v", tc.amount, tc.muls, tc.expected, tc.err, r.Amount(), err)
+		}
+	}
+}
+
+func TestMoney_Divide(t *testing.T) {
+	r, err := New(100, "EUR").Divide(3, RoundHalfUp)
+	if err != nil || r != New(33, "EUR") {
+		t.Errorf("Expected %v got %v, %v", New(33, "EUR"), r, err)
+	}
+
+	if _, err := New(100, "EUR").Divide(0, RoundHalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+}
+
+func TestMoney_Round(t *testing.T) {
+	r, err := New(12550, "EUR").Round(RoundHalfEven)
+	if err != nil || r != New(12600, "EUR") {
+		t.Errorf("Expected %v got %v, %v", New(12600, "EUR"), r, err)
+	}
+}
+
+func TestMoney_Split(t *testing.T) {
+	ms, err := New(100, "EUR").Split(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Money{New(34, "EUR"), New(33, "EUR"), New(33, "EUR")}
+	for i := range expected {
+		if ms[i] != expected[i] {
+			t.Errorf("Expected %v got %v", expected, ms)
+		}
+	}
+
+	if _, err := New(100, "EUR").Split(0); err == nil {
+		t.Error("Expected an error splitting in 0 parties")
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	ms, err := New(100, "EUR").Allocate(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ms) != 2 || ms[0] != New(34, "EUR") || ms[1] != New(66, "EUR") {
+		t.Errorf("Expected [34 66] got %v", ms)
+	}
+}
+
+func TestMoney_Display(t *testing.T) {
+	if New(123456, "EUR").String() != "€1,234.56" {
+		t.Errorf("Expected %s got %s", "€1,234.56", New(123456, "EUR").String())
+	}
+
+	if New(123456, "EUR").AsMajorUnits() != 1234.56 {
+		t.Errorf("Expected %f got %f", 1234.56, New(123456, "EUR").AsMajorUnits())
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	b, err := json.Marshal(New(123, "EUR"))
+	if err != nil || string(b) != `{"amount":123,"currency":"EUR"}` {
+		t.Errorf("Unexpected JSON %s, %v", b, err)
+	}
+
+	var m Money
+	if err := json.Unmarshal(b, &m); err != nil || m != New(123, "EUR") {
+		t.Errorf("Expected %v got %v, %v", New(123, "EUR"), m, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount": "foo"}`), &m); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
+
+func TestMoney_DB(t *testing.T) {
+	v, err := New(10, "EUR").Value()
+	if err != nil || v != "10|EUR" {
+		t.Errorf("Expected %s got %v, %v", "10|EUR", v, err)
+	}
+
+	var m Money
+	if err := m.Scan("10|EUR"); err != nil || m != New(10, "EUR") {
+		t.Errorf("Expected %v got %v, %v", New(10, "EUR"), m, err)
+	}
+
+	if err := m.Scan("foo"); err == nil {
+		t.Error("Expected an error for an invalid value")
+	}
+}